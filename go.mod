@@ -3,6 +3,8 @@ module github.com/terraform-providers/terraform-provider-prismacloudcompute
 require (
 	github.com/hashicorp/terraform-plugin-sdk v1.17.2
 	github.com/paloaltonetworks/prisma-cloud-compute-go v0.0.0-20210806212641-79968d82fd40
+	golang.org/x/net v0.0.0-20210326060303-6b1517762897
+	gopkg.in/yaml.v2 v2.3.0
 )
 
 go 1.13
@@ -0,0 +1,21 @@
+package complianceCheck
+
+const (
+	plural = "compliance checks"
+)
+
+// Compliance templates the built-in checks are grouped into.
+const (
+	TemplateCISDocker     = "CIS Docker"
+	TemplateCISKubernetes = "CIS Kubernetes"
+	TemplateDISASTIG      = "DISA STIG"
+	TemplateGDPR          = "GDPR"
+	TemplateHIPAA         = "HIPAA"
+	TemplateNIST800190    = "NIST SP 800-190"
+	TemplatePCI           = "PCI"
+)
+
+// TypeCustom is the type given to custom compliance checks.
+const TypeCustom = "custom"
+
+var Suffix = []string{"static", "vulnerabilities"}
@@ -0,0 +1,31 @@
+package complianceCheck
+
+import (
+	"strings"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+)
+
+// List returns the built-in compliance checks known to the Console.
+func List(c pc.PrismaCloudClient) ([]Check, error) {
+	c.Log(pc.LogAction, "(get) list of %s", plural)
+
+	var ans staticVulnerabilities
+	if _, err := c.Communicate("GET", Suffix, nil, nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.ComplianceVulnerabilities, nil
+}
+
+// InTemplate reports whether the check is part of the given template.
+// Template names are compared case insensitively.
+func (o Check) InTemplate(template string) bool {
+	for _, v := range o.Templates {
+		if strings.EqualFold(v, template) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,14 @@
+package complianceCheck
+
+type Check struct {
+	Id          int      `json:"id"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Severity    string   `json:"severity,omitempty"`
+	Templates   []string `json:"templates,omitempty"`
+}
+
+type staticVulnerabilities struct {
+	ComplianceVulnerabilities []Check `json:"complianceVulnerabilities"`
+}
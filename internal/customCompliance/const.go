@@ -0,0 +1,16 @@
+package customCompliance
+
+const (
+	singular = "custom compliance check"
+	plural   = "custom compliance checks"
+)
+
+// Valid values for severity
+const (
+	SeverityCritical = "critical"
+	SeverityHigh     = "high"
+	SeverityMedium   = "medium"
+	SeverityLow      = "low"
+)
+
+var Suffix = []string{"custom-compliance"}
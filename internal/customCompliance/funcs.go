@@ -0,0 +1,83 @@
+package customCompliance
+
+import (
+	"strconv"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+)
+
+// List returns a list of all custom compliance checks.
+func List(c pc.PrismaCloudClient) ([]CustomCompliance, error) {
+	c.Log(pc.LogAction, "(get) list of %s", plural)
+
+	var ans []CustomCompliance
+	if _, err := c.Communicate("GET", Suffix, nil, nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// Get returns the custom compliance check that has the specified ID.
+func Get(c pc.PrismaCloudClient, id int) (CustomCompliance, error) {
+	c.Log(pc.LogAction, "(get) %s id:%d", singular, id)
+
+	listing, err := List(c)
+	if err != nil {
+		return CustomCompliance{}, err
+	}
+
+	for _, elm := range listing {
+		if elm.Id == id {
+			return elm, nil
+		}
+	}
+
+	return CustomCompliance{}, pc.ObjectNotFoundError
+}
+
+// Identify returns the ID of the custom compliance check with the given name.
+func Identify(c pc.PrismaCloudClient, name string) (int, error) {
+	c.Log(pc.LogAction, "(get) id for %s name:%s", singular, name)
+
+	listing, err := List(c)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, elm := range listing {
+		if elm.Name == name {
+			return elm.Id, nil
+		}
+	}
+
+	return 0, pc.ObjectNotFoundError
+}
+
+// Create adds a new custom compliance check.  The Console assigns its ID.
+func Create(c pc.PrismaCloudClient, check CustomCompliance) error {
+	c.Log(pc.LogAction, "(create) %s", singular)
+
+	check.Id = 0
+	_, err := c.Communicate("PUT", Suffix, nil, check, nil)
+	return err
+}
+
+// Update modifies the existing custom compliance check.
+func Update(c pc.PrismaCloudClient, check CustomCompliance) error {
+	c.Log(pc.LogAction, "(update) %s id:%d", singular, check.Id)
+
+	_, err := c.Communicate("PUT", Suffix, nil, check, nil)
+	return err
+}
+
+// Delete removes a custom compliance check using its ID.
+func Delete(c pc.PrismaCloudClient, id int) error {
+	c.Log(pc.LogAction, "(delete) %s id:%d", singular, id)
+
+	path := make([]string, 0, len(Suffix)+1)
+	path = append(path, Suffix...)
+	path = append(path, strconv.Itoa(id))
+	_, err := c.Communicate("DELETE", path, nil, nil, nil)
+	return err
+}
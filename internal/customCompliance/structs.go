@@ -0,0 +1,11 @@
+package customCompliance
+
+type CustomCompliance struct {
+	Id       int    `json:"_id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Severity string `json:"severity,omitempty"`
+	Script   string `json:"script,omitempty"`
+	Modified string `json:"modified,omitempty"`
+	Owner    string `json:"owner,omitempty"`
+}
@@ -0,0 +1,13 @@
+package policySecrets
+
+const (
+	singular = "secrets policy"
+)
+
+// Valid values for how secrets are injected into containers.
+const (
+	InjectionEnvironment = "environment"
+	InjectionFile        = "file"
+)
+
+var Suffix = []string{"policies", "secrets"}
@@ -0,0 +1,22 @@
+package policySecrets
+
+import (
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+)
+
+// Get returns the secrets policy.
+func Get(c pc.PrismaCloudClient) (Policy, error) {
+	c.Log(pc.LogAction, "(get) %s", singular)
+
+	var ans Policy
+	_, err := c.Communicate("GET", Suffix, nil, nil, &ans)
+	return ans, err
+}
+
+// Update replaces the secrets policy.
+func Update(c pc.PrismaCloudClient, policy Policy) error {
+	c.Log(pc.LogAction, "(update) %s", singular)
+
+	_, err := c.Communicate("PUT", Suffix, nil, policy, nil)
+	return err
+}
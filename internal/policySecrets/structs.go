@@ -0,0 +1,31 @@
+package policySecrets
+
+import (
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/collection"
+)
+
+type Policy struct {
+	Id    string `json:"_id,omitempty"`
+	Rules []Rule `json:"rules"`
+}
+
+type Rule struct {
+	Name        string                  `json:"name"`
+	Collections []collection.Collection `json:"collections"`
+	Secrets     []Secret                `json:"secrets"`
+	Injection   string                  `json:"injection"`
+	Target      string                  `json:"target,omitempty"`
+	ReadAllPerm bool                    `json:"readAllPerm"`
+	Disabled    bool                    `json:"disabled"`
+	Notes       string                  `json:"notes,omitempty"`
+	Owner       string                  `json:"owner,omitempty"`
+	Modified    string                  `json:"modified,omitempty"`
+}
+
+// Secret is injected into containers under Name, with the value at Path
+// in the named secrets store.
+type Secret struct {
+	Name  string `json:"name"`
+	Store string `json:"store"`
+	Path  string `json:"path"`
+}
@@ -0,0 +1,16 @@
+package secretsStore
+
+const (
+	singular = "secrets store"
+	plural   = "secrets stores"
+)
+
+// Valid values for the type of a secrets store.
+const (
+	TypeVault             = "hashicorpVault"
+	TypeAwsSecretsManager = "awsSecretsManager"
+	TypeAzureKeyVault     = "azureKeyVault"
+	TypeCyberArk          = "cyberArk"
+)
+
+var Suffix = []string{"settings", "secrets"}
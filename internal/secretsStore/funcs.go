@@ -0,0 +1,119 @@
+package secretsStore
+
+import (
+	"fmt"
+	"sync"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+)
+
+// All stores are saved as one document, so changes to it are serialized
+// to keep concurrent changes from overwriting each other.
+var mu sync.Mutex
+
+func getSettings(c pc.PrismaCloudClient) (Settings, error) {
+	var ans Settings
+	_, err := c.Communicate("GET", Suffix, nil, nil, &ans)
+	return ans, err
+}
+
+func putSettings(c pc.PrismaCloudClient, s Settings) error {
+	_, err := c.Communicate("POST", Suffix, nil, s, nil)
+	return err
+}
+
+// List returns a list of all secrets stores.
+func List(c pc.PrismaCloudClient) ([]Store, error) {
+	c.Log(pc.LogAction, "(get) list of %s", plural)
+
+	s, err := getSettings(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.SecretsStores, nil
+}
+
+// Get returns the secrets store that has the specified name.
+func Get(c pc.PrismaCloudClient, name string) (Store, error) {
+	c.Log(pc.LogAction, "(get) %s name:%s", singular, name)
+
+	listing, err := List(c)
+	if err != nil {
+		return Store{}, err
+	}
+
+	for _, elm := range listing {
+		if elm.Name == name {
+			return elm, nil
+		}
+	}
+
+	return Store{}, pc.ObjectNotFoundError
+}
+
+// Create adds a new secrets store.
+func Create(c pc.PrismaCloudClient, store Store) error {
+	c.Log(pc.LogAction, "(create) %s name:%s", singular, store.Name)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	s, err := getSettings(c)
+	if err != nil {
+		return err
+	}
+
+	for _, elm := range s.SecretsStores {
+		if elm.Name == store.Name {
+			return fmt.Errorf("%s %q already exists", singular, store.Name)
+		}
+	}
+	s.SecretsStores = append(s.SecretsStores, store)
+
+	return putSettings(c, s)
+}
+
+// Update modifies the existing secrets store.
+func Update(c pc.PrismaCloudClient, store Store) error {
+	c.Log(pc.LogAction, "(update) %s name:%s", singular, store.Name)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	s, err := getSettings(c)
+	if err != nil {
+		return err
+	}
+
+	for i := range s.SecretsStores {
+		if s.SecretsStores[i].Name == store.Name {
+			s.SecretsStores[i] = store
+			return putSettings(c, s)
+		}
+	}
+
+	return pc.ObjectNotFoundError
+}
+
+// Delete removes a secrets store using its name.
+func Delete(c pc.PrismaCloudClient, name string) error {
+	c.Log(pc.LogAction, "(delete) %s name:%s", singular, name)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	s, err := getSettings(c)
+	if err != nil {
+		return err
+	}
+
+	for i := range s.SecretsStores {
+		if s.SecretsStores[i].Name == name {
+			s.SecretsStores = append(s.SecretsStores[:i], s.SecretsStores[i+1:]...)
+			return putSettings(c, s)
+		}
+	}
+
+	return pc.ObjectNotFoundError
+}
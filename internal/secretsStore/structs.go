@@ -0,0 +1,17 @@
+package secretsStore
+
+// Settings is the Console document that holds all secrets stores.
+type Settings struct {
+	RefreshPeriodHours int     `json:"refreshPeriodHours,omitempty"`
+	SecretsStores      []Store `json:"secretsStores"`
+}
+
+type Store struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Url          string `json:"url,omitempty"`
+	Region       string `json:"region,omitempty"`
+	CredentialId string `json:"credentialID,omitempty"`
+	AppId        string `json:"appID,omitempty"`
+	CaCert       string `json:"caCert,omitempty"`
+}
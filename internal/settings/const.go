@@ -0,0 +1,96 @@
+package settings
+
+// Valid values for the type of a SAML identity provider.
+const (
+	SamlTypeOkta       = "okta"
+	SamlTypeGsuite     = "gsuite"
+	SamlTypePing       = "ping"
+	SamlTypeShibboleth = "shibboleth"
+	SamlTypeAzure      = "azure"
+	SamlTypeAdfs       = "adfs"
+)
+
+// Valid values for the provider of OAuth 2.0 logins.
+const (
+	OauthProviderGithub    = "github"
+	OauthProviderOpenshift = "openshift"
+)
+
+// Valid values for the log level of Defenders.
+const (
+	LogLevelError   = "error"
+	LogLevelWarning = "warning"
+	LogLevelInfo    = "info"
+	LogLevelDebug   = "debug"
+)
+
+// Valid values for the message format of syslog forwarding.
+const (
+	SyslogProtocolRfc3164 = "rfc3164"
+	SyslogProtocolRfc5424 = "rfc5424"
+)
+
+// Valid values for the categories of audit events to log.
+const (
+	AuditCategoryAccess        = "access"
+	AuditCategoryAdmission     = "admission"
+	AuditCategoryAppFirewall   = "appFirewall"
+	AuditCategoryCompliance    = "compliance"
+	AuditCategoryIncidents     = "incidents"
+	AuditCategoryKubernetes    = "kubernetes"
+	AuditCategoryNetwork       = "network"
+	AuditCategoryRuntime       = "runtime"
+	AuditCategoryVulnerability = "vulnerability"
+)
+
+// AuditCategories lists all categories of audit events.
+var AuditCategories = []string{
+	AuditCategoryAccess,
+	AuditCategoryAdmission,
+	AuditCategoryAppFirewall,
+	AuditCategoryCompliance,
+	AuditCategoryIncidents,
+	AuditCategoryKubernetes,
+	AuditCategoryNetwork,
+	AuditCategoryRuntime,
+	AuditCategoryVulnerability,
+}
+
+// Valid values for the provider of a cloud account.
+const (
+	CloudProviderAws   = "aws"
+	CloudProviderAzure = "azure"
+	CloudProviderGcp   = "gcp"
+)
+
+// Valid values for the type of code repositories.
+const (
+	CodeRepoTypeGithub    = "github"
+	CodeRepoTypeGitlab    = "gitlab"
+	CodeRepoTypeBitbucket = "bitbucket"
+)
+
+var Suffix = []string{"settings"}
+
+// Names of the settings documents under Suffix.
+const (
+	LogonName = "logon"
+	LdapName  = "ldap"
+	SamlName  = "saml"
+	OidcName  = "oidc"
+	OauthName = "oauth"
+	ScanName  = "scan"
+
+	DefenderName = "defender"
+	RuntimeName  = "runtime"
+	ForensicName = "forensic"
+	LoggingName  = "logging"
+
+	CloudDiscoveryName = "cloud-discovery"
+	CloudScanName      = "cloud-scan"
+
+	ServerlessScanName = "serverless-scan"
+	VmImageScanName    = "vm"
+	TasScanName        = "tas"
+	CodeReposName      = "coderepos"
+)
@@ -0,0 +1,358 @@
+package settings
+
+import (
+	"bytes"
+	"encoding/json"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+)
+
+// DefaultLogon are the logon settings of a new Console.
+var DefaultLogon = Logon{
+	SessionTimeoutSec: 1800,
+}
+
+// The identity provider settings of a new Console, which are turned off.
+// Stored secrets are not part of them and are left as they are.
+var (
+	DefaultLdap = Ldap{
+		UserSearchIdentifier: "uid",
+	}
+	DefaultSaml  = Saml{}
+	DefaultOidc  = Oidc{}
+	DefaultOauth = Oauth{}
+)
+
+// DefaultScan are the scan settings of a new Console: everything is
+// scanned daily, one image at a time per Defender.
+var DefaultScan = Scan{
+	ImagesScanPeriodMs:         day,
+	ContainersScanPeriodMs:     day,
+	SystemScanPeriodMs:         day,
+	RegistryScanPeriodMs:       day,
+	ServerlessScanPeriodMs:     day,
+	VmScanPeriodMs:             day,
+	TasScanPeriodMs:            day,
+	CloudPlatformsScanPeriodMs: day,
+	CodeReposScanPeriodMs:      day,
+	ScanConcurrency:            1,
+	ScanRunningImages:          true,
+	ShowInfraContainers:        true,
+}
+
+const day = 24 * 60 * 60 * 1000
+
+// DefaultDefender are the Defender settings of a new Console.
+var DefaultDefender = Defender{
+	ListeningPort:        9998,
+	LogLevel:             LogLevelInfo,
+	DisconnectPeriodDays: 1,
+}
+
+// DefaultRuntime are the runtime settings of a new Console.
+var DefaultRuntime = Runtime{
+	AutomaticLearning:   true,
+	LearningPeriodHours: 24,
+}
+
+// DefaultForensic are the forensic settings of a new Console.
+var DefaultForensic = Forensic{
+	Enabled:              true,
+	ContainerDiskUsageMb: 10,
+	HostDiskUsageMb:      1000,
+	IncidentSnapshotsCap: 1000,
+}
+
+// DefaultCloudDiscovery are the cloud discovery settings of a new Console:
+// no accounts are discovered.
+var DefaultCloudDiscovery = CloudDiscovery{
+	Accounts:          []CloudAccount{},
+	DiscoveryPeriodMs: day,
+}
+
+// DefaultCloudScan are the cloud compliance scan settings of a new
+// Console: no accounts are scanned.
+var DefaultCloudScan = CloudScan{
+	Accounts: []CloudAccount{},
+}
+
+// DefaultServerlessScan are the serverless scan settings of a new Console:
+// no functions are scanned.
+var DefaultServerlessScan = ServerlessScan{
+	Accounts: []ServerlessScanAccount{},
+}
+
+// DefaultVmImageScan are the VM image scan settings of a new Console: no
+// images are scanned.
+var DefaultVmImageScan = VmImageScan{
+	Specifications: []VmImageSpec{},
+}
+
+// DefaultTasScan are the VMware Tanzu scan settings of a new Console: no
+// droplets are scanned.
+var DefaultTasScan = TasScan{
+	Specifications: []TasSpec{},
+}
+
+// DefaultCodeRepos are the code repository scan settings of a new Console:
+// no repositories are scanned.
+var DefaultCodeRepos = CodeRepos{
+	Specifications: []CodeRepoSpec{},
+}
+
+// DefaultLogging are the logging settings of a new Console: events are
+// only kept in the Console, and audits of every category are logged.
+var DefaultLogging = Logging{
+	AuditRetentionDays: 30,
+	AuditCategories:    AuditCategories,
+}
+
+func get(c pc.PrismaCloudClient, name string, ans interface{}) error {
+	c.Log(pc.LogAction, "(get) %s settings", name)
+
+	path := make([]string, 0, len(Suffix)+1)
+	path = append(path, Suffix...)
+	path = append(path, name)
+	_, err := c.Communicate("GET", path, nil, nil, ans)
+	return err
+}
+
+/*
+update overlays obj on the current settings document and posts the result, so
+the fields of the document that obj does not model are kept as they are.
+Nested objects are overlaid the same way, anything else is replaced.
+*/
+func update(c pc.PrismaCloudClient, name string, obj interface{}) error {
+	var doc map[string]interface{}
+	if err := get(c, name, &doc); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var fields map[string]interface{}
+	if err := dec.Decode(&fields); err != nil {
+		return err
+	}
+
+	if doc == nil {
+		doc = make(map[string]interface{})
+	}
+	overlay(doc, fields)
+
+	c.Log(pc.LogAction, "(update) %s settings", name)
+
+	path := make([]string, 0, len(Suffix)+1)
+	path = append(path, Suffix...)
+	path = append(path, name)
+	_, err = c.Communicate("POST", path, nil, doc, nil)
+	return err
+}
+
+func overlay(dst, src map[string]interface{}) {
+	for key, val := range src {
+		if obj, ok := val.(map[string]interface{}); ok {
+			if cur, ok := dst[key].(map[string]interface{}); ok {
+				overlay(cur, obj)
+				continue
+			}
+		}
+		dst[key] = val
+	}
+}
+
+// GetLogon returns the Console's logon settings.
+func GetLogon(c pc.PrismaCloudClient) (Logon, error) {
+	var ans Logon
+	err := get(c, LogonName, &ans)
+	return ans, err
+}
+
+// UpdateLogon replaces the Console's logon settings.
+func UpdateLogon(c pc.PrismaCloudClient, obj Logon) error {
+	return update(c, LogonName, obj)
+}
+
+// GetLdap returns the Console's LDAP settings.
+func GetLdap(c pc.PrismaCloudClient) (Ldap, error) {
+	var ans Ldap
+	err := get(c, LdapName, &ans)
+	return ans, err
+}
+
+// UpdateLdap replaces the Console's LDAP settings.
+func UpdateLdap(c pc.PrismaCloudClient, obj Ldap) error {
+	return update(c, LdapName, obj)
+}
+
+// GetSaml returns the Console's SAML settings.
+func GetSaml(c pc.PrismaCloudClient) (Saml, error) {
+	var ans Saml
+	err := get(c, SamlName, &ans)
+	return ans, err
+}
+
+// UpdateSaml replaces the Console's SAML settings.
+func UpdateSaml(c pc.PrismaCloudClient, obj Saml) error {
+	return update(c, SamlName, obj)
+}
+
+// GetOidc returns the Console's OpenID Connect settings.
+func GetOidc(c pc.PrismaCloudClient) (Oidc, error) {
+	var ans Oidc
+	err := get(c, OidcName, &ans)
+	return ans, err
+}
+
+// UpdateOidc replaces the Console's OpenID Connect settings.
+func UpdateOidc(c pc.PrismaCloudClient, obj Oidc) error {
+	return update(c, OidcName, obj)
+}
+
+// GetOauth returns the Console's OAuth 2.0 settings.
+func GetOauth(c pc.PrismaCloudClient) (Oauth, error) {
+	var ans Oauth
+	err := get(c, OauthName, &ans)
+	return ans, err
+}
+
+// UpdateOauth replaces the Console's OAuth 2.0 settings.
+func UpdateOauth(c pc.PrismaCloudClient, obj Oauth) error {
+	return update(c, OauthName, obj)
+}
+
+// GetScan returns the Console's scan settings.
+func GetScan(c pc.PrismaCloudClient) (Scan, error) {
+	var ans Scan
+	err := get(c, ScanName, &ans)
+	return ans, err
+}
+
+// UpdateScan replaces the Console's scan settings.
+func UpdateScan(c pc.PrismaCloudClient, obj Scan) error {
+	return update(c, ScanName, obj)
+}
+
+// GetDefender returns the Console's Defender settings.
+func GetDefender(c pc.PrismaCloudClient) (Defender, error) {
+	var ans Defender
+	err := get(c, DefenderName, &ans)
+	return ans, err
+}
+
+// UpdateDefender replaces the Console's Defender settings.
+func UpdateDefender(c pc.PrismaCloudClient, obj Defender) error {
+	return update(c, DefenderName, obj)
+}
+
+// GetRuntime returns the Console's runtime settings.
+func GetRuntime(c pc.PrismaCloudClient) (Runtime, error) {
+	var ans Runtime
+	err := get(c, RuntimeName, &ans)
+	return ans, err
+}
+
+// UpdateRuntime replaces the Console's runtime settings.
+func UpdateRuntime(c pc.PrismaCloudClient, obj Runtime) error {
+	return update(c, RuntimeName, obj)
+}
+
+// GetForensic returns the Console's forensic settings.
+func GetForensic(c pc.PrismaCloudClient) (Forensic, error) {
+	var ans Forensic
+	err := get(c, ForensicName, &ans)
+	return ans, err
+}
+
+// UpdateForensic replaces the Console's forensic settings.
+func UpdateForensic(c pc.PrismaCloudClient, obj Forensic) error {
+	return update(c, ForensicName, obj)
+}
+
+// GetLogging returns the Console's logging settings.
+func GetLogging(c pc.PrismaCloudClient) (Logging, error) {
+	var ans Logging
+	err := get(c, LoggingName, &ans)
+	return ans, err
+}
+
+// UpdateLogging replaces the Console's logging settings.
+func UpdateLogging(c pc.PrismaCloudClient, obj Logging) error {
+	return update(c, LoggingName, obj)
+}
+
+// GetCloudDiscovery returns the Console's cloud discovery settings.
+func GetCloudDiscovery(c pc.PrismaCloudClient) (CloudDiscovery, error) {
+	var ans CloudDiscovery
+	err := get(c, CloudDiscoveryName, &ans)
+	return ans, err
+}
+
+// UpdateCloudDiscovery replaces the Console's cloud discovery settings.
+func UpdateCloudDiscovery(c pc.PrismaCloudClient, obj CloudDiscovery) error {
+	return update(c, CloudDiscoveryName, obj)
+}
+
+// GetCloudScan returns the Console's cloud compliance scan settings.
+func GetCloudScan(c pc.PrismaCloudClient) (CloudScan, error) {
+	var ans CloudScan
+	err := get(c, CloudScanName, &ans)
+	return ans, err
+}
+
+// UpdateCloudScan replaces the Console's cloud compliance scan settings.
+func UpdateCloudScan(c pc.PrismaCloudClient, obj CloudScan) error {
+	return update(c, CloudScanName, obj)
+}
+
+// GetServerlessScan returns the Console's serverless scan settings.
+func GetServerlessScan(c pc.PrismaCloudClient) (ServerlessScan, error) {
+	var ans ServerlessScan
+	err := get(c, ServerlessScanName, &ans)
+	return ans, err
+}
+
+// UpdateServerlessScan replaces the Console's serverless scan settings.
+func UpdateServerlessScan(c pc.PrismaCloudClient, obj ServerlessScan) error {
+	return update(c, ServerlessScanName, obj)
+}
+
+// GetVmImageScan returns the Console's VM image scan settings.
+func GetVmImageScan(c pc.PrismaCloudClient) (VmImageScan, error) {
+	var ans VmImageScan
+	err := get(c, VmImageScanName, &ans)
+	return ans, err
+}
+
+// UpdateVmImageScan replaces the Console's VM image scan settings.
+func UpdateVmImageScan(c pc.PrismaCloudClient, obj VmImageScan) error {
+	return update(c, VmImageScanName, obj)
+}
+
+// GetTasScan returns the Console's VMware Tanzu scan settings.
+func GetTasScan(c pc.PrismaCloudClient) (TasScan, error) {
+	var ans TasScan
+	err := get(c, TasScanName, &ans)
+	return ans, err
+}
+
+// UpdateTasScan replaces the Console's VMware Tanzu scan settings.
+func UpdateTasScan(c pc.PrismaCloudClient, obj TasScan) error {
+	return update(c, TasScanName, obj)
+}
+
+// GetCodeRepos returns the Console's code repository scan settings.
+func GetCodeRepos(c pc.PrismaCloudClient) (CodeRepos, error) {
+	var ans CodeRepos
+	err := get(c, CodeReposName, &ans)
+	return ans, err
+}
+
+// UpdateCodeRepos replaces the Console's code repository scan settings.
+func UpdateCodeRepos(c pc.PrismaCloudClient, obj CodeRepos) error {
+	return update(c, CodeReposName, obj)
+}
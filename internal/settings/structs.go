@@ -0,0 +1,211 @@
+package settings
+
+import (
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/collection"
+)
+
+// Secret is a credential stored by the Console.  Set Plain when writing;
+// the Console only ever returns the Encrypted form.
+type Secret struct {
+	Encrypted string `json:"encrypted"`
+	Plain     string `json:"plain"`
+}
+
+type Logon struct {
+	SessionTimeoutSec     int  `json:"sessionTimeoutSec"`
+	BasicAuthDisabled     bool `json:"basicAuthDisabled"`
+	StrongPassword        bool `json:"strongPassword"`
+	UseSupportCredentials bool `json:"useSupportCredentials"`
+}
+
+type Ldap struct {
+	Enabled              bool    `json:"enabled"`
+	Url                  string  `json:"url"`
+	SearchBase           string  `json:"searchBase"`
+	UserSearchIdentifier string  `json:"userSearchIdentifier"`
+	GroupSearchBase      string  `json:"groupSearchBase"`
+	AccountUpn           bool    `json:"accountUpn"`
+	User                 string  `json:"user"`
+	Password             *Secret `json:"password,omitempty"`
+	CaCert               string  `json:"caCert"`
+}
+
+type Saml struct {
+	Enabled       bool    `json:"enabled"`
+	Type          string  `json:"type"`
+	Url           string  `json:"url"`
+	Issuer        string  `json:"issuer"`
+	Audience      string  `json:"audience"`
+	Cert          string  `json:"cert"`
+	ConsoleUrl    string  `json:"consoleURL"`
+	ProviderAlias string  `json:"providerAlias"`
+	AppId         string  `json:"appId"`
+	TenantId      string  `json:"tenantId"`
+	AppSecret     *Secret `json:"appSecret,omitempty"`
+}
+
+type Oidc struct {
+	Enabled       bool    `json:"enabled"`
+	ProviderAlias string  `json:"providerAlias"`
+	ClientId      string  `json:"clientID"`
+	ClientSecret  *Secret `json:"clientSecret,omitempty"`
+	AuthUrl       string  `json:"authURL"`
+	TokenUrl      string  `json:"tokenURL"`
+	UserClaim     string  `json:"userClaim"`
+	GroupScope    string  `json:"groupScope"`
+	GroupClaim    string  `json:"groupClaim"`
+	Cert          string  `json:"cert"`
+}
+
+type Oauth struct {
+	Enabled      bool    `json:"enabled"`
+	Provider     string  `json:"provider"`
+	ClientId     string  `json:"clientID"`
+	ClientSecret *Secret `json:"clientSecret,omitempty"`
+	AuthUrl      string  `json:"authURL"`
+	TokenUrl     string  `json:"tokenURL"`
+	UserClaim    string  `json:"userClaim"`
+	GroupScope   string  `json:"groupScope"`
+	GroupClaim   string  `json:"groupClaim"`
+	Cert         string  `json:"cert"`
+}
+
+// Scan periods are in milliseconds.
+type Scan struct {
+	ImagesScanPeriodMs            int  `json:"imagesScanPeriodMs"`
+	ContainersScanPeriodMs        int  `json:"containersScanPeriodMs"`
+	SystemScanPeriodMs            int  `json:"systemScanPeriodMs"`
+	RegistryScanPeriodMs          int  `json:"registryScanPeriodMs"`
+	ServerlessScanPeriodMs        int  `json:"serverlessScanPeriodMs"`
+	VmScanPeriodMs                int  `json:"vmScanPeriodMs"`
+	TasScanPeriodMs               int  `json:"tasScanPeriodMs"`
+	CloudPlatformsScanPeriodMs    int  `json:"cloudPlatformsScanPeriodMs"`
+	CodeReposScanPeriodMs         int  `json:"codeReposScanPeriodMs"`
+	ScanConcurrency               int  `json:"scanConcurrency"`
+	RegistryScanRetentionDays     int  `json:"registryScanRetentionDays"`
+	ScanRunningImages             bool `json:"scanRunningImages"`
+	ShowNegligibleVulnerabilities bool `json:"showNegligibleVulnerabilities"`
+	ShowInfraContainers           bool `json:"showInfraContainers"`
+	ExtractArchive                bool `json:"extractArchive"`
+}
+
+type Defender struct {
+	ListeningPort           int    `json:"listeningPort"`
+	AdmissionControlEnabled bool   `json:"admissionControlEnabled"`
+	LogLevel                string `json:"logLevel"`
+	DisconnectPeriodDays    int    `json:"disconnectPeriodDays"`
+}
+
+type Runtime struct {
+	AutomaticLearning   bool `json:"automaticLearningEnabled"`
+	LearningPeriodHours int  `json:"learningPeriodHours"`
+}
+
+type Forensic struct {
+	Enabled                bool `json:"enabled"`
+	ContainerDiskUsageMb   int  `json:"containerDiskUsageMb"`
+	HostDiskUsageMb        int  `json:"hostDiskUsageMb"`
+	IncidentSnapshotsCap   int  `json:"incidentSnapshotsCap"`
+	CollectNetworkSnapshot bool `json:"collectNetworkSnapshot"`
+}
+
+type Logging struct {
+	AuditRetentionDays      int      `json:"auditRetentionDays"`
+	Syslog                  Syslog   `json:"syslog"`
+	Stdout                  Stdout   `json:"stdout"`
+	EnableMetricsCollection bool     `json:"enableMetricsCollection"`
+	AuditCategories         []string `json:"auditCategories"`
+}
+
+type Syslog struct {
+	Enabled    bool   `json:"enabled"`
+	Addr       string `json:"addr"`
+	Protocol   string `json:"protocol,omitempty"`
+	Identifier string `json:"identifier"`
+	Verbose    bool   `json:"verbose"`
+}
+
+type Stdout struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+}
+
+// CloudAccount selects what is scanned in a cloud account.  Empty
+// regions or services select all of them.
+type CloudAccount struct {
+	CredentialId string   `json:"credentialId"`
+	Provider     string   `json:"provider"`
+	Regions      []string `json:"regions"`
+	Services     []string `json:"services"`
+}
+
+type CloudDiscovery struct {
+	Accounts          []CloudAccount `json:"accounts"`
+	DiscoveryPeriodMs int            `json:"discoveryPeriodMs"`
+}
+
+type CloudScan struct {
+	Accounts []CloudAccount `json:"accounts"`
+}
+
+type ServerlessScan struct {
+	Accounts []ServerlessScanAccount `json:"accounts"`
+}
+
+// ServerlessScanAccount selects the functions scanned in a cloud account.
+// Functions are selected by the Functions of the collections, and Cap
+// limits how many are scanned, zero meaning all of them.
+type ServerlessScanAccount struct {
+	Provider     string                  `json:"provider"`
+	CredentialId string                  `json:"credentialID"`
+	Regions      []string                `json:"regions"`
+	Collections  []collection.Collection `json:"collections"`
+	ScanLayers   bool                    `json:"scanLayers"`
+	Cap          int                     `json:"cap"`
+}
+
+// VmImageScan and TasScan hold a list of specifications, each selecting
+// what to scan with one credential, the same way registry scan settings do.
+// Scanners is the number of Defenders that scan in parallel, and Cap limits
+// how many of the newest images are scanned, zero meaning all of them.
+type VmImageScan struct {
+	Specifications []VmImageSpec `json:"specifications"`
+}
+
+type VmImageSpec struct {
+	Provider       string   `json:"provider"`
+	Region         string   `json:"region"`
+	CredentialId   string   `json:"credentialID"`
+	Image          string   `json:"image"`
+	ExcludedImages []string `json:"excludedImages"`
+	Cap            int      `json:"cap"`
+	Scanners       int      `json:"scanners"`
+}
+
+type TasScan struct {
+	Specifications []TasSpec `json:"specifications"`
+}
+
+type TasSpec struct {
+	CloudControllerAddress string   `json:"cloudControllerAddress"`
+	CredentialId           string   `json:"credentialID"`
+	Pattern                string   `json:"pattern"`
+	ExcludedApps           []string `json:"excludedApps"`
+	Cap                    int      `json:"cap"`
+	Scanners               int      `json:"scanners"`
+}
+
+type CodeRepos struct {
+	Specifications []CodeRepoSpec `json:"specifications"`
+}
+
+// CodeRepoSpec selects repositories by glob, such as "org/*".  Only the
+// ExplicitManifestNames are scanned if set, otherwise all manifests found
+// outside of the ExcludedManifestPaths are.
+type CodeRepoSpec struct {
+	Type                  string   `json:"type"`
+	CredentialId          string   `json:"credentialID"`
+	Repositories          []string `json:"repositories"`
+	ExcludedManifestPaths []string `json:"excludedManifestPaths"`
+	ExplicitManifestNames []string `json:"explicitManifestNames"`
+}
@@ -0,0 +1,16 @@
+package tag
+
+const (
+	singular = "tag"
+	plural   = "tags"
+)
+
+// Valid values for the resource type of a tag assignment.
+const (
+	ResourceTypeImage    = "image"
+	ResourceTypeHost     = "host"
+	ResourceTypeFunction = "function"
+	ResourceTypeCodeRepo = "codeRepo"
+)
+
+var Suffix = []string{"tags"}
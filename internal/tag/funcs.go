@@ -0,0 +1,97 @@
+package tag
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+)
+
+// List returns a list of all tags.
+func List(c pc.PrismaCloudClient) ([]Tag, error) {
+	c.Log(pc.LogAction, "(get) list of %s", plural)
+
+	var ans []Tag
+	if _, err := c.Communicate("GET", Suffix, nil, nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// Get returns the tag that has the specified name.
+func Get(c pc.PrismaCloudClient, name string) (Tag, error) {
+	c.Log(pc.LogAction, "(get) %s name:%s", singular, name)
+
+	listing, err := List(c)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	for _, elm := range listing {
+		if elm.Name == name {
+			return elm, nil
+		}
+	}
+
+	return Tag{}, pc.ObjectNotFoundError
+}
+
+// Create adds a new tag.
+func Create(c pc.PrismaCloudClient, tag Tag) error {
+	c.Log(pc.LogAction, "(create) %s", singular)
+
+	_, err := c.Communicate("POST", Suffix, nil, tag, nil)
+	return err
+}
+
+// Update modifies the existing tag, which may be renamed by giving it a new name.
+func Update(c pc.PrismaCloudClient, name string, tag Tag) error {
+	c.Log(pc.LogAction, "(update) %s name:%s", singular, name)
+
+	_, err := c.Communicate("PUT", path(name), nil, tag, nil)
+	return err
+}
+
+// Delete removes a tag using its name.
+func Delete(c pc.PrismaCloudClient, name string) error {
+	c.Log(pc.LogAction, "(delete) %s name:%s", singular, name)
+
+	_, err := c.Communicate("DELETE", path(name), nil, nil, nil)
+	return err
+}
+
+// Assign assigns the tag to the given vulnerability.
+func Assign(c pc.PrismaCloudClient, name string, vuln Vuln) error {
+	c.Log(pc.LogAction, "(assign) %s name:%s vuln:%s", singular, name, describe(vuln))
+
+	_, err := c.Communicate("POST", append(path(name), "vuln"), nil, vuln, nil)
+	return err
+}
+
+// Unassign removes the tag from the given vulnerability.
+func Unassign(c pc.PrismaCloudClient, name string, vuln Vuln) error {
+	c.Log(pc.LogAction, "(unassign) %s name:%s vuln:%s", singular, name, describe(vuln))
+
+	_, err := c.Communicate("DELETE", append(path(name), "vuln"), nil, vuln, nil)
+	return err
+}
+
+func path(name string) []string {
+	ans := make([]string, 0, len(Suffix)+2)
+	ans = append(ans, Suffix...)
+	return append(ans, url.PathEscape(name))
+}
+
+func describe(vuln Vuln) string {
+	var buf strings.Builder
+	buf.WriteString(vuln.Id)
+	if vuln.PackageName != "" {
+		fmt.Fprintf(&buf, " package:%s", vuln.PackageName)
+	}
+	if vuln.ResourceType != "" {
+		fmt.Fprintf(&buf, " %s:%s", vuln.ResourceType, vuln.ResourceName)
+	}
+	return buf.String()
+}
@@ -0,0 +1,18 @@
+package tag
+
+type Tag struct {
+	Name        string `json:"name,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+	Vulns       []Vuln `json:"vulns,omitempty"`
+}
+
+// Vuln is a CVE, optionally narrowed to a package and resource, that a tag
+// is assigned to.
+type Vuln struct {
+	Id           string `json:"id"`
+	PackageName  string `json:"packageName,omitempty"`
+	ResourceType string `json:"resourceType,omitempty"`
+	ResourceName string `json:"resourceName,omitempty"`
+	Comment      string `json:"comment,omitempty"`
+}
@@ -0,0 +1,24 @@
+package user
+
+const (
+	singular = "user"
+	plural   = "users"
+)
+
+// Valid values for the role of a user.
+const (
+	RoleAdmin                = "admin"
+	RoleOperator             = "operator"
+	RoleAuditor              = "auditor"
+	RoleDevSecOps            = "devSecOps"
+	RoleVulnerabilityManager = "vulnerabilityManager"
+	RoleDefenderManager      = "defenderManager"
+	RoleDevOps               = "devOps"
+	RoleCi                   = "ci"
+	RoleUser                 = "user"
+)
+
+// AuthTypeBasic is the type of users that log in with a password.
+const AuthTypeBasic = "basic"
+
+var Suffix = []string{"users"}
@@ -0,0 +1,77 @@
+package user
+
+import (
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+)
+
+// List returns a list of all users.
+func List(c pc.PrismaCloudClient) ([]User, error) {
+	c.Log(pc.LogAction, "(get) list of %s", plural)
+
+	var ans []User
+	if _, err := c.Communicate("GET", Suffix, nil, nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// Get returns the user that has the specified username.
+func Get(c pc.PrismaCloudClient, username string) (User, error) {
+	c.Log(pc.LogAction, "(get) %s username:%s", singular, username)
+
+	listing, err := List(c)
+	if err != nil {
+		return User{}, err
+	}
+
+	for _, elm := range listing {
+		if elm.Username == username {
+			return elm, nil
+		}
+	}
+
+	return User{}, pc.ObjectNotFoundError
+}
+
+// Create adds a new user.
+func Create(c pc.PrismaCloudClient, obj User) error {
+	c.Log(pc.LogAction, "(create) %s username:%s", singular, obj.Username)
+
+	_, err := c.Communicate("POST", Suffix, nil, obj, nil)
+	return err
+}
+
+// Update modifies the existing user.  The password is left as is unless
+// one is given.
+func Update(c pc.PrismaCloudClient, obj User) error {
+	c.Log(pc.LogAction, "(update) %s username:%s", singular, obj.Username)
+
+	_, err := c.Communicate("PUT", Suffix, nil, obj, nil)
+	return err
+}
+
+// SetPassword replaces the password of the user.
+func SetPassword(c pc.PrismaCloudClient, username, password string) error {
+	obj, err := Get(c, username)
+	if err != nil {
+		return err
+	}
+
+	c.Log(pc.LogAction, "(update) password of %s username:%s", singular, username)
+
+	obj.Password = password
+	_, err = c.Communicate("PUT", Suffix, nil, obj, nil)
+	return err
+}
+
+// Delete removes a user using its username.
+func Delete(c pc.PrismaCloudClient, username string) error {
+	c.Log(pc.LogAction, "(delete) %s username:%s", singular, username)
+
+	path := make([]string, 0, len(Suffix)+1)
+	path = append(path, Suffix...)
+	path = append(path, username)
+	_, err := c.Communicate("DELETE", path, nil, nil, nil)
+	return err
+}
@@ -0,0 +1,17 @@
+package user
+
+type User struct {
+	Username     string       `json:"username"`
+	Password     string       `json:"password,omitempty"`
+	Role         string       `json:"role"`
+	AuthType     string       `json:"authType"`
+	Permissions  []Permission `json:"permissions,omitempty"`
+	LastModified string       `json:"lastModified,omitempty"`
+}
+
+// Permission limits what a user can see to some collections.  An empty
+// list of collections means all of them.
+type Permission struct {
+	Project     string   `json:"project,omitempty"`
+	Collections []string `json:"collections"`
+}
@@ -0,0 +1,158 @@
+package prismacloudcompute
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Placeholder for redacted values.
+const redacted = "********"
+
+// Bodies longer than this are truncated in the logs.
+const maxLoggedBody = 64 * 1024
+
+/*
+Keys (case-insensitive substrings) whose values are never logged, in JSON
+bodies and query strings alike.
+*/
+var sensitiveKeys = []string{
+	"password",
+	"passwd",
+	"passphrase",
+	"secret",
+	"token",
+	"privatekey",
+	"private_key",
+	"apikey",
+	"api_key",
+	"cookie",
+	"authorization",
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+loggingTransport logs each API call as one `[DEBUG]` line with the method,
+path, status and latency, and when enabled, the request and response bodies
+as `[TRACE]` lines with sensitive values masked.
+*/
+type loggingTransport struct {
+	bodies bool
+	next   http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := redactedPath(req.URL)
+
+	if t.bodies && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := ioutil.ReadAll(body)
+			body.Close()
+			if len(b) > 0 {
+				logTrace("api request", "method", req.Method, "path", path, "body", logRaw(redactBody(b)))
+			}
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	latency := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		logDebug("api call", "method", req.Method, "path", path, "latency", latency, "error", err)
+		return resp, err
+	}
+
+	logDebug("api call", "method", req.Method, "path", path, "status", resp.StatusCode, "latency", latency)
+
+	if t.bodies && resp.Body != nil {
+		b, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+		if err != nil {
+			return resp, err
+		}
+		if len(b) > 0 {
+			logTrace("api response", "method", req.Method, "path", path, "status", resp.StatusCode, "body", logRaw(redactBody(b)))
+		}
+	}
+
+	return resp, nil
+}
+
+// redactedPath returns the URL path and query, with sensitive query values
+// masked.
+func redactedPath(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.Path
+	}
+
+	query := u.Query()
+	for key := range query {
+		if isSensitiveKey(key) {
+			query[key] = []string{redacted}
+		}
+	}
+
+	return u.Path + "?" + query.Encode()
+}
+
+/*
+redactBody returns a JSON body in compact form with the values of sensitive
+keys masked.  Bodies that are not JSON are summarized rather than logged, as
+there is no telling what is in them.
+*/
+func redactBody(b []byte) string {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return fmt.Sprintf("<%d bytes, not JSON>", len(b))
+	}
+
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes>", len(b))
+	}
+	if len(out) > maxLoggedBody {
+		return fmt.Sprintf("%s... <truncated, %d bytes>", out[:maxLoggedBody], len(out))
+	}
+
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for key, item := range val {
+			if isSensitiveKey(key) {
+				if item != nil && item != "" {
+					val[key] = redacted
+				}
+			} else {
+				val[key] = redactValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = redactValue(item)
+		}
+	}
+
+	return v
+}
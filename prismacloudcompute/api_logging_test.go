@@ -0,0 +1,75 @@
+package prismacloudcompute
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		body     string
+		expected string
+	}{
+		{`{"username":"admin","password":"hunter2"}`, `{"password":"********","username":"admin"}`},
+		{`{"token":"eyJ.x.y"}`, `{"token":"********"}`},
+		{`[{"name":"aws","secret":{"encrypted":"abc"},"accessKeyId":"AKIA"}]`, `[{"accessKeyId":"AKIA","name":"aws","secret":"********"}]`},
+		{`{"spec":{"apiToken":""}}`, `{"spec":{"apiToken":""}}`},
+		{`{"count":12345678901234567890}`, `{"count":12345678901234567890}`},
+		{`password=hunter2`, `<16 bytes, not JSON>`},
+	}
+
+	for _, tc := range cases {
+		if got := redactBody([]byte(tc.body)); got != tc.expected {
+			t.Errorf("%s: got %s, expected %s", tc.body, got, tc.expected)
+		}
+	}
+}
+
+func TestRedactedPath(t *testing.T) {
+	u, _ := url.Parse("https://console/api/v1/policies?project=Central&token=abc")
+	if got, expected := redactedPath(u), "/api/v1/policies?project=Central&token=%2A%2A%2A%2A%2A%2A%2A%2A"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestLoggingTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"token":"eyJ.issued.token"}`)
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	client := &http.Client{Transport: &loggingTransport{bodies: true, next: http.DefaultTransport}}
+	resp, err := client.Post(srv.URL+"/api/v1/authenticate", "application/json", strings.NewReader(`{"username":"admin","password":"hunter2"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body bytes.Buffer
+	body.ReadFrom(resp.Body)
+	resp.Body.Close()
+
+	out := buf.String()
+	for _, secret := range []string{"hunter2", "eyJ.issued.token"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log contains %q:\n%s", secret, out)
+		}
+	}
+	for _, expected := range []string{"[DEBUG] prismacloudcompute: api call: method=POST path=/api/v1/authenticate status=200 latency=", "[TRACE]", `"username":"admin"`} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("log does not contain %q:\n%s", expected, out)
+		}
+	}
+	if body.String() != `{"token":"eyJ.issued.token"}` {
+		t.Errorf("response body was not preserved: %s", body.String())
+	}
+}
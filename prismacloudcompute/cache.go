@@ -0,0 +1,198 @@
+package prismacloudcompute
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Only responses under these API paths are cached.
+var cachedPaths = []string{
+	"/api/v1/policies/",
+}
+
+func isCachedPath(path string) bool {
+	for _, p := range cachedPaths {
+		if strings.Contains(path, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+responseCache holds the policy documents read during one Terraform run, so
+that resources and data sources reading the same policy share one API call.
+
+It belongs to a provider instance, and a write to a policy drops that policy
+from it.
+*/
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	path   string
+	header http.Header
+	body   []byte
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{entries: make(map[string]cacheEntry)}
+}
+
+func (c *responseCache) get(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	return e, ok
+}
+
+func (c *responseCache) put(key string, e cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = e
+}
+
+// invalidatePath drops the cached reads of the given path and of the paths
+// under or above it, in any project.
+func (c *responseCache) invalidatePath(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, e := range c.entries {
+		if e.path == path || strings.HasPrefix(e.path, path+"/") || strings.HasPrefix(path, e.path+"/") {
+			delete(c.entries, key)
+		}
+	}
+}
+
+/*
+cacheTransport answers GETs of policies from the cache.
+
+Cached responses that came with an ETag or Last-Modified header are
+revalidated with a conditional request, which the Console answers with a
+bodyless 304 if the policy has not changed.  Responses without either are
+reused until the policy is written, so changes made in the Console meanwhile
+are only seen by requests sent with `Cache-Control: no-cache`.  Those always
+go to the Console, and replace the cached policy, logging when the `modified`
+times of its rules moved since it was cached.
+*/
+type cacheTransport struct {
+	cache *responseCache
+	next  http.RoundTripper
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isCachedPath(req.URL.Path) {
+		return t.next.RoundTrip(req)
+	}
+
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		t.cache.invalidatePath(req.URL.Path)
+		return t.next.RoundTrip(req)
+	}
+
+	key := req.URL.String()
+	entry, cached := t.cache.get(key)
+	if cached && req.Header.Get("Cache-Control") == "no-cache" {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || req.Method != http.MethodGet || resp.StatusCode != http.StatusOK {
+			return resp, err
+		}
+		b, err := t.store(key, req, resp)
+		if err == nil && !sameModified(entry.body, b) {
+			logDebug("cached policy changed on the Console", "path", redactedPath(req.URL))
+		}
+		return resp, err
+	}
+	if cached {
+		etag, lastModified := entry.header.Get("ETag"), entry.header.Get("Last-Modified")
+		if etag == "" && lastModified == "" {
+			logDebug("api call served from cache", "method", req.Method, "path", redactedPath(req.URL))
+			return entry.response(req), nil
+		}
+
+		req = req.Clone(req.Context())
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	switch {
+	case cached && resp.StatusCode == http.StatusNotModified:
+		resp.Body.Close()
+		return entry.response(req), nil
+	case req.Method == http.MethodGet && resp.StatusCode == http.StatusOK:
+		_, err = t.store(key, req, resp)
+	}
+
+	return resp, err
+}
+
+// store caches a successful GET, leaving the response readable.
+func (t *cacheTransport) store(key string, req *http.Request, resp *http.Response) ([]byte, error) {
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	t.cache.put(key, cacheEntry{path: req.URL.Path, header: resp.Header.Clone(), body: b})
+	return b, nil
+}
+
+/*
+sameModified reports whether two versions of a policy document have the same
+rules with the same `modified` times.  Documents that cannot be compared are
+reported as changed.
+*/
+func sameModified(a, b []byte) bool {
+	var x, y struct {
+		Rules []struct {
+			Name     string `json:"name"`
+			Modified string `json:"modified"`
+		} `json:"rules"`
+	}
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil || len(x.Rules) != len(y.Rules) {
+		return false
+	}
+
+	for i := range x.Rules {
+		if x.Rules[i] != y.Rules[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
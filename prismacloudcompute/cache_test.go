@@ -0,0 +1,178 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func cacheGet(t *testing.T, client *http.Client, url string) string {
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status %d", resp.StatusCode)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	return string(b)
+}
+
+func TestCacheTransport(t *testing.T) {
+	version, gets := 1, 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			version++
+		case http.MethodGet:
+			gets++
+			fmt.Fprintf(w, `{"version":%d}`, version)
+		}
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &cacheTransport{cache: newResponseCache(), next: http.DefaultTransport}}
+	policies := srv.URL + "/api/v1/policies/runtime/container"
+
+	for i := 0; i < 3; i++ {
+		if got := cacheGet(t, client, policies); got != `{"version":1}` {
+			t.Errorf("read %d: got %s", i, got)
+		}
+	}
+	if gets != 1 {
+		t.Errorf("got %d GETs before the write, expected 1", gets)
+	}
+
+	req, _ := http.NewRequest(http.MethodPut, policies, strings.NewReader(`{}`))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if got := cacheGet(t, client, policies); got != `{"version":2}` {
+		t.Errorf("read after write: got %s", got)
+	}
+	if gets != 2 {
+		t.Errorf("got %d GETs, expected 2", gets)
+	}
+
+	cacheGet(t, client, srv.URL+"/api/v1/collections")
+	cacheGet(t, client, srv.URL+"/api/v1/collections")
+	if gets != 4 {
+		t.Errorf("got %d GETs, expected collections not to be cached", gets)
+	}
+}
+
+func TestCacheTransportRevalidation(t *testing.T) {
+	etag, full, notModified := `"v1"`, 0, 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full++
+		w.Header().Set("ETag", etag)
+		fmt.Fprintf(w, `{"etag":%q}`, etag)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &cacheTransport{cache: newResponseCache(), next: http.DefaultTransport}}
+	policies := srv.URL + "/api/v1/policies/compliance/host"
+
+	cacheGet(t, client, policies)
+	if got := cacheGet(t, client, policies); got != `{"etag":"\"v1\""}` {
+		t.Errorf("revalidated read: got %s", got)
+	}
+	if full != 1 || notModified != 1 {
+		t.Errorf("got %d full and %d not modified responses, expected 1 and 1", full, notModified)
+	}
+
+	etag = `"v2"`
+	if got := cacheGet(t, client, policies); got != `{"etag":"\"v2\""}` {
+		t.Errorf("changed policy: got %s", got)
+	}
+}
+
+func TestCacheTransportChangedWithoutValidators(t *testing.T) {
+	modified, gets := "2021-06-01T00:00:00Z", 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+		case http.MethodGet:
+			gets++
+			fmt.Fprintf(w, `{"rules":[{"name":"rule","modified":%q}]}`, modified)
+		}
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &cacheTransport{cache: newResponseCache(), next: http.DefaultTransport}}
+	runtime := srv.URL + "/api/v1/policies/runtime/container"
+	compliance := srv.URL + "/api/v1/policies/compliance/container"
+	before := `{"rules":[{"name":"rule","modified":"2021-06-01T00:00:00Z"}]}`
+	after := `{"rules":[{"name":"rule","modified":"2021-06-02T00:00:00Z"}]}`
+
+	cacheGet(t, client, runtime)
+	cacheGet(t, client, compliance)
+
+	// Changed in the Console: plain reads keep the cached policy.
+	modified = "2021-06-02T00:00:00Z"
+	if got := cacheGet(t, client, runtime); got != before {
+		t.Errorf("cached read: got %s", got)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, runtime, nil)
+	req.Header.Set("Cache-Control", "no-cache")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(b) != after {
+		t.Errorf("no-cache read: got %s", b)
+	}
+	if got := cacheGet(t, client, runtime); got != after {
+		t.Errorf("read after no-cache read: got %s", got)
+	}
+	if gets != 3 {
+		t.Errorf("got %d GETs, expected 3", gets)
+	}
+
+	// Writing one policy leaves the others cached.
+	req, _ = http.NewRequest(http.MethodPut, runtime, strings.NewReader(`{}`))
+	if resp, err = client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	cacheGet(t, client, compliance)
+	cacheGet(t, client, runtime)
+	if gets != 4 {
+		t.Errorf("got %d GETs, expected only the written policy to be read again", gets)
+	}
+}
+
+func TestSameModified(t *testing.T) {
+	a := []byte(`{"rules":[{"name":"a","modified":"1"},{"name":"b","modified":"1"}]}`)
+	cases := []struct {
+		b    string
+		same bool
+	}{
+		{`{"_id":"x","rules":[{"name":"a","modified":"1","effect":"block"},{"name":"b","modified":"1"}]}`, true},
+		{`{"rules":[{"name":"a","modified":"2"},{"name":"b","modified":"1"}]}`, false},
+		{`{"rules":[{"name":"a","modified":"1"}]}`, false},
+		{`not json`, false},
+	}
+
+	for _, tc := range cases {
+		if got := sameModified(a, []byte(tc.b)); got != tc.same {
+			t.Errorf("%s: got %t, expected %t", tc.b, got, tc.same)
+		}
+	}
+}
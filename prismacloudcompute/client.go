@@ -0,0 +1,230 @@
+package prismacloudcompute
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+)
+
+// Refresh the JSON web token when it expires within this window.
+const tokenRefreshWindow = 2 * time.Minute
+
+// Host name of Prisma Cloud Compute SaaS Consoles, prefixed by the region.
+const saasDomain = "cloud.twistlock.com"
+
+/*
+apiClient is the meta handed to every resource and data source.
+
+It embeds the SDK connection settings, so it can be passed to the SDK
+functions as is, sends their requests itself (see connection.go) and carries the provider-level settings that resources need next to it.
+*/
+type apiClient struct {
+	*pc.Client
+
+	// Connection the client sends its requests over, set by Initialize.
+	httpClient *http.Client
+
+	// CVE rule and tag exceptions expiring within this many days are
+	// warned about during plan.
+	exceptionWarningDays int
+
+	// Expired CVE rule and tag exceptions are left out of the policies sent
+	// to the Console.
+	dropExpiredExceptions bool
+
+	// Project (tenant console behind a master console) that requests are
+	// sent to, empty for the console the connection points at.
+	project string
+
+	// Rotated token to pick up before each request, nil if not used.
+	tokenFile *tokenFile
+
+	// Reads go to the Console even if the policy is cached.
+	noCache bool
+
+	// Requests are sent without the token, to log in as someone else.
+	noToken bool
+
+	// Guards re-authentication ahead of the token's expiry.  Shared by
+	// every per-project copy of the client, as they share the token.
+	authMu *sync.Mutex
+
+	// Guards the JSON web token, which is read by every request while
+	// another one may be renewing it.  Use token and setToken.
+	tokenMu *sync.RWMutex
+}
+
+func newApiClient(con *pc.Client) *apiClient {
+	return &apiClient{
+		Client:  con,
+		authMu:  &sync.Mutex{},
+		tokenMu: &sync.RWMutex{},
+	}
+}
+
+// token returns the JSON web token the requests are sent with.
+func (c *apiClient) token() string {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+
+	return c.JsonWebToken
+}
+
+// setToken replaces the JSON web token of the client and all its copies.
+func (c *apiClient) setToken(token string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	c.JsonWebToken = token
+}
+
+// attributeGetter is satisfied by both *schema.ResourceData and
+// *schema.ResourceDiff.
+type attributeGetter interface {
+	Get(key string) interface{}
+}
+
+/*
+withProject returns the client to use for a resource or data source, which is
+this client unless the resource sets its own `project`.
+*/
+func (c *apiClient) withProject(d attributeGetter) *apiClient {
+	project, _ := d.Get("project").(string)
+	if project == "" || project == c.project {
+		return c
+	}
+
+	cp := *c
+	cp.project = project
+	return &cp
+}
+
+// fresh returns a copy of the client whose reads bypass the policy cache.
+func (c *apiClient) fresh() *apiClient {
+	cp := *c
+	cp.noCache = true
+	return &cp
+}
+
+/*
+Communicate picks up a rotated `token_file`, renews the JSON web token shortly
+before it expires, adds the `project` query parameter when a project is
+selected, then sends the call to the Console.
+
+The SDK only re-authenticates after a request has been rejected, which is
+what SaaS Consoles with short-lived tokens end up doing on every few calls.
+*/
+func (c *apiClient) Communicate(method string, suffix []string, query, data interface{}, ans interface{}) ([]byte, error) {
+	if err := c.reloadTokenFile(); err != nil {
+		return nil, err
+	}
+	if err := c.refreshToken(); err != nil {
+		return nil, err
+	}
+
+	if c.project != "" {
+		values := url.Values{}
+		if q, ok := query.(url.Values); ok {
+			for key, val := range q {
+				values[key] = val
+			}
+		}
+		values.Set("project", c.project)
+		query = values
+	}
+
+	return c.communicate(method, suffix, query, data, ans, true)
+}
+
+func (c *apiClient) refreshToken() error {
+	if c.DisableReconnect || c.Username == "" || c.Password == "" {
+		return nil
+	}
+
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	exp, ok := tokenExpiry(c.token())
+	if !ok || time.Until(exp) > tokenRefreshWindow {
+		return nil
+	}
+
+	c.Log(pc.LogAction, "(auth) token expires at %s, renewing", exp.Format(time.RFC3339))
+	return c.Authenticate()
+}
+
+// reloadTokenFile switches to the token in `token_file` when it changes.
+func (c *apiClient) reloadTokenFile() error {
+	if c.tokenFile == nil {
+		return nil
+	}
+
+	token, changed, err := c.tokenFile.read()
+	if err != nil || !changed {
+		return err
+	}
+
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	c.Log(pc.LogAction, "(auth) token_file changed, using the new token")
+	c.setToken(token)
+	return nil
+}
+
+// tokenExpiry returns the `exp` claim of a JSON web token.
+func tokenExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(claims.Exp, 0), true
+}
+
+/*
+consoleUrl builds the Console URL (without the protocol) the SDK connects to.
+
+SaaS Consoles live under a region-specific host with a tenant path prefix,
+for example us-east1.cloud.twistlock.com/us-1-111111111, so the API is served
+from /{tenant}/api/v1.  The region is only used when no URL is given.
+*/
+func consoleUrl(url, region, tenant string) (string, error) {
+	url = strings.TrimRight(url, "/")
+	if url == "" && region != "" {
+		url = fmt.Sprintf("%s.%s", region, saasDomain)
+	}
+
+	tenant = strings.Trim(tenant, "/")
+	if tenant == "" {
+		return url, nil
+	}
+
+	if url == "" {
+		return "", fmt.Errorf("tenant %q requires either url or region to be set", tenant)
+	}
+	if !strings.HasSuffix(url, "/"+tenant) {
+		url = url + "/" + tenant
+	}
+
+	return url, nil
+}
@@ -0,0 +1,155 @@
+package prismacloudcompute
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func testToken(exp time.Time) string {
+	payload, _ := json.Marshal(map[string]int64{"exp": exp.Unix()})
+	return fmt.Sprintf("e30.%s.sig", base64.RawURLEncoding.EncodeToString(payload))
+}
+
+func TestConsoleUrl(t *testing.T) {
+	cases := []struct {
+		url, region, tenant string
+		expected            string
+		err                 bool
+	}{
+		{"console.example.com", "", "", "console.example.com", false},
+		{"console.example.com/", "", "", "console.example.com", false},
+		{"", "us-east1", "", "us-east1.cloud.twistlock.com", false},
+		{"", "us-east1", "us-1-111111111", "us-east1.cloud.twistlock.com/us-1-111111111", false},
+		{"console.example.com", "us-east1", "/us-1-111111111/", "console.example.com/us-1-111111111", false},
+		{"console.example.com/us-1-111111111", "", "us-1-111111111", "console.example.com/us-1-111111111", false},
+		{"", "", "us-1-111111111", "", true},
+	}
+
+	for _, tc := range cases {
+		url, err := consoleUrl(tc.url, tc.region, tc.tenant)
+		if (err != nil) != tc.err {
+			t.Errorf("%q %q %q: unexpected error state: %v", tc.url, tc.region, tc.tenant, err)
+		} else if url != tc.expected {
+			t.Errorf("%q %q %q: got %q, expected %q", tc.url, tc.region, tc.tenant, url, tc.expected)
+		}
+	}
+}
+
+func TestTokenExpiry(t *testing.T) {
+	exp := time.Unix(1700000000, 0)
+	if got, ok := tokenExpiry(testToken(exp)); !ok || !got.Equal(exp) {
+		t.Errorf("got %s (%t), expected %s", got, ok, exp)
+	}
+
+	for _, token := range []string{"", "opaque", "a.b.c", "e30.e30.sig"} {
+		if _, ok := tokenExpiry(token); ok {
+			t.Errorf("%q: expected no expiry", token)
+		}
+	}
+}
+
+func TestRefreshTokenBeforeExpiry(t *testing.T) {
+	logins := 0
+	tokens := []string{
+		testToken(time.Now().Add(30 * time.Second)),
+		testToken(time.Now().Add(time.Hour)),
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/us-1-111111111/api/v1/authenticate":
+			fmt.Fprintf(w, `{"token": %q}`, tokens[logins])
+			logins++
+		case "/us-1-111111111/api/v1/collections":
+			if r.Header.Get("Authorization") != "Bearer "+tokens[1] {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, "[]")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	url, err := consoleUrl(strings.TrimPrefix(srv.URL, "http://"), "", "us-1-111111111")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newApiClient(&pc.Client{
+		Url:      url,
+		Protocol: "http",
+		Username: "access-key",
+		Password: "secret-key",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		var ans []interface{}
+		if _, err := client.Communicate("GET", []string{"collections"}, nil, nil, &ans); err != nil {
+			t.Fatalf("request %d: %s", i, err)
+		}
+	}
+
+	if logins != 2 {
+		t.Errorf("got %d logins, expected 2", logins)
+	}
+}
+
+func TestProjectQueryParameter(t *testing.T) {
+	var projects []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/authenticate":
+			fmt.Fprint(w, `{"token": "opaque"}`)
+		default:
+			projects = append(projects, r.URL.Query().Get("project"))
+			fmt.Fprint(w, "[]")
+		}
+	}))
+	defer srv.Close()
+
+	client := newApiClient(&pc.Client{
+		Url:      strings.TrimPrefix(srv.URL, "http://"),
+		Protocol: "http",
+		Username: "admin",
+		Password: "admin",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+	client.project = "central"
+
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{"project": projectSchema()}}
+	inherited := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	overridden := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"project": "branch"})
+
+	var ans []interface{}
+	for _, d := range []*schema.ResourceData{inherited, overridden} {
+		if _, err := client.withProject(d).Communicate("GET", []string{"collections"}, url.Values{"id": []string{"x"}}, nil, &ans); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(projects) != 2 || projects[0] != "central" || projects[1] != "branch" {
+		t.Errorf("got projects %q, expected [central branch]", projects)
+	}
+	if client.project != "central" {
+		t.Errorf("provider project changed to %q", client.project)
+	}
+}
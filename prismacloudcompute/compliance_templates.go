@@ -0,0 +1,205 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/complianceCheck"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var complianceTemplates = []string{
+	complianceCheck.TemplateCISDocker,
+	complianceCheck.TemplateCISKubernetes,
+	complianceCheck.TemplateDISASTIG,
+	complianceCheck.TemplateGDPR,
+	complianceCheck.TemplateHIPAA,
+	complianceCheck.TemplateNIST800190,
+	complianceCheck.TemplatePCI,
+}
+
+// complianceTemplateSchema is the `template` attribute of compliance rules.
+func complianceTemplateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  fmt.Sprintf("Compliance template whose checks are added to the rule's conditions. Can be set to '%s'. Checks listed in the rule's conditions override the template's.", strings.Join(complianceTemplates, "', '")),
+		ValidateFunc: validation.StringInSlice(complianceTemplates, true),
+	}
+}
+
+// templateChecksSchema is the computed `template_checks` attribute, which
+// shows the checks each rule's template expands to in the plan.
+func templateChecksSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Checks added to each rule by its template, as listed by the Console during plan.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"rule": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the rule.",
+				},
+				"template": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Compliance template of the rule.",
+				},
+				"checks": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "IDs of the checks in the template.",
+					Elem: &schema.Schema{
+						Type: schema.TypeInt,
+					},
+				},
+			},
+		},
+	}
+}
+
+// templateCheckIds returns the IDs of the checks in the given template.
+func templateCheckIds(checks []complianceCheck.Check, template string) []int {
+	ans := make([]int, 0)
+	for _, v := range checks {
+		if v.InTemplate(template) {
+			ans = append(ans, v.Id)
+		}
+	}
+
+	return ans
+}
+
+/*
+mergeTemplateChecks adds the checks with the given IDs to a rule's conditions.
+
+Checks already in the conditions are kept as they are, so they act as
+per-check overrides of the template (for example to block on a single check).
+*/
+func mergeTemplateChecks(explicit []policy.Vulnerability, ids []int) []policy.Vulnerability {
+	seen := make(map[int]bool, len(explicit))
+	for _, v := range explicit {
+		seen[v.Id] = true
+	}
+
+	ans := explicit
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		ans = append(ans, policy.Vulnerability{Id: id})
+		seen[id] = true
+	}
+
+	return ans
+}
+
+/*
+expandComplianceTemplates adds the planned `template_checks` to the conditions
+of the rules they were computed for.  The checks are taken from the plan rather
+than listed again, so what is applied is what the plan showed.
+*/
+func expandComplianceTemplates(d *schema.ResourceData, rules []policy.Rule) []policy.Rule {
+	items, _ := d.Get("template_checks").([]interface{})
+	byRule := make(map[string][]int, len(items))
+	for _, v := range items {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := item["rule"].(string)
+		checks, _ := item["checks"].([]interface{})
+		for _, id := range checks {
+			byRule[name] = append(byRule[name], id.(int))
+		}
+	}
+
+	for i := range rules {
+		ids, ok := byRule[rules[i].Name]
+		if !ok {
+			continue
+		}
+		rules[i].Condition.Vulnerabilities = mergeTemplateChecks(rules[i].Condition.Vulnerabilities, ids)
+		logDebug("expanded compliance template", "rule", rules[i].Name, "checks", len(rules[i].Condition.Vulnerabilities))
+	}
+
+	return rules
+}
+
+/*
+customizeComplianceTemplates returns a CustomizeDiff function that resolves
+the template of each rule in the `key` rule list into `template_checks`, so the
+checks a template adds show up in the plan.  A template without checks fails
+the plan instead of silently adding nothing.
+*/
+func customizeComplianceTemplates(key string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*apiClient)
+		if !ok {
+			return nil
+		}
+		client = client.withProject(d)
+
+		rules, _ := d.Get(key).([]interface{})
+		var checks []complianceCheck.Check
+		ans := make([]interface{}, 0)
+		for _, v := range rules {
+			item, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			template, _ := item["template"].(string)
+			if template == "" {
+				continue
+			}
+
+			if checks == nil {
+				var err error
+				if checks, err = listComplianceChecks(client); err != nil {
+					return err
+				}
+			}
+
+			ids := templateCheckIds(checks, template)
+			if len(ids) == 0 {
+				return fmt.Errorf("Rule %q: compliance template %q has no checks on this Console", item["name"], template)
+			}
+			ans = append(ans, map[string]interface{}{
+				"rule":     item["name"],
+				"template": template,
+				"checks":   ids,
+			})
+		}
+
+		old, _ := d.Get("template_checks").([]interface{})
+		if len(old) == 0 && len(ans) == 0 {
+			return nil
+		}
+		if reflect.DeepEqual(normalizeTemplateChecks(old), normalizeTemplateChecks(ans)) {
+			return nil
+		}
+
+		return d.SetNew("template_checks", ans)
+	}
+}
+
+// normalizeTemplateChecks gives computed and stored `template_checks` the
+// same shape so they can be compared.
+func normalizeTemplateChecks(items []interface{}) []string {
+	ans := make([]string, 0, len(items))
+	for _, v := range items {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ans = append(ans, fmt.Sprintf("%v|%v|%v", item["rule"], item["template"], item["checks"]))
+	}
+
+	return ans
+}
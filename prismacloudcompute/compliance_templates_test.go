@@ -0,0 +1,103 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/complianceCheck"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestMergeTemplateChecks(t *testing.T) {
+	checks := []complianceCheck.Check{
+		{Id: 41, Templates: []string{"CIS Docker", "PCI"}},
+		{Id: 422, Templates: []string{"CIS Docker"}},
+		{Id: 531, Templates: []string{"GDPR"}},
+	}
+	explicit := []policy.Vulnerability{{Id: 422, Block: true}, {Id: 9001, Block: true}}
+
+	ans := mergeTemplateChecks(explicit, templateCheckIds(checks, "cis docker"))
+
+	got := make(map[int]bool)
+	for _, v := range ans {
+		if _, ok := got[v.Id]; ok {
+			t.Errorf("Check %d is listed twice", v.Id)
+		}
+		got[v.Id] = v.Block
+	}
+
+	expected := map[int]bool{41: false, 422: true, 9001: true}
+	if len(got) != len(expected) {
+		t.Fatalf("Got checks %v, expected %v", got, expected)
+	}
+	for id, block := range expected {
+		if b, ok := got[id]; !ok || b != block {
+			t.Errorf("Check %d: got block=%t (present=%t), expected block=%t", id, b, ok, block)
+		}
+	}
+}
+
+func TestComplianceTemplatesInPlan(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/authenticate":
+			fmt.Fprint(w, `{"token": "opaque"}`)
+		case "/api/v1/static/vulnerabilities":
+			fmt.Fprint(w, `{"complianceVulnerabilities": [{"id": 41, "templates": ["CIS Docker", "PCI"]}, {"id": 422, "templates": ["CIS Docker"]}, {"id": 531, "templates": ["GDPR"]}]}`)
+		case "/api/v1/custom-compliance":
+			fmt.Fprint(w, `[]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := newApiClient(&pc.Client{
+		Url:      strings.TrimPrefix(srv.URL, "http://"),
+		Protocol: "http",
+		Username: "admin",
+		Password: "admin",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+
+	rule := func(template string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"rule": []interface{}{map[string]interface{}{
+				"name":     "baseline",
+				"effect":   "alert",
+				"template": template,
+			}},
+		})
+	}
+
+	diff, err := resourcePoliciesComplianceContainer().Diff(nil, rule("CIS Docker"), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"template_checks.#":          "1",
+		"template_checks.0.rule":     "baseline",
+		"template_checks.0.template": "CIS Docker",
+		"template_checks.0.checks.#": "2",
+		"template_checks.0.checks.0": "41",
+		"template_checks.0.checks.1": "422",
+	}
+	for k, v := range expected {
+		if attr, ok := diff.Attributes[k]; !ok || attr.New != v {
+			t.Errorf("%s is %#v in the plan, expected %q", k, attr, v)
+		}
+	}
+
+	if _, err := resourcePoliciesComplianceContainer().Diff(nil, rule("HIPAA"), client); err == nil {
+		t.Errorf("Expected a template without checks to fail the plan")
+	}
+}
@@ -0,0 +1,175 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func rulesModifiedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Description: "When each rule was last modified, by rule name, as of the last time Terraform wrote the policy.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func forceOverwriteSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Overwrite the policy even if its rules were changed outside of Terraform since the last read.",
+	}
+}
+
+// policyRules reads the current rules of a policy from the Console.
+type policyRules func(c *apiClient) ([]policy.Rule, error)
+
+// rulesModified returns the `rules_modified` map of the given rules.
+func rulesModified(rules []policy.Rule) map[string]interface{} {
+	ans := make(map[string]interface{}, len(rules))
+	for _, rule := range rules {
+		ans[rule.Name] = rule.Modified
+	}
+
+	return ans
+}
+
+/*
+The `rules_modified` map is the baseline that changes made outside of
+Terraform are detected against.  It is recorded when Terraform creates or
+updates the policy, and on the first read after an import, but never by a
+refresh, which would make whatever was changed in the Console the new baseline
+and let the next apply silently overwrite it.
+*/
+
+// initRulesModified records the baseline on the first read of an imported
+// policy, and leaves it alone afterwards.
+func initRulesModified(d *schema.ResourceData, rules []policy.Rule) {
+	if known, _ := d.Get("rules_modified").(map[string]interface{}); len(known) == 0 {
+		d.Set("rules_modified", rulesModified(rules))
+	}
+}
+
+// recordRulesModified records the baseline after Terraform wrote the policy.
+func (c *apiClient) recordRulesModified(d *schema.ResourceData, current policyRules) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	rules, err := current(c)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("rules_modified", rulesModified(rules))
+}
+
+/*
+customizeRulesUnchanged returns a CustomizeDiff function that compares the
+policy's current rules with the `rules_modified` baseline, so that changes
+made outside of Terraform fail the plan instead of being overwritten by the
+apply.  With `force_overwrite` set, the plan updates the policy instead, which
+records a new baseline.
+*/
+func customizeRulesUnchanged(name string, current policyRules) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*apiClient)
+		if !ok || d.Id() == "" {
+			return nil
+		}
+
+		known, _ := d.Get("rules_modified").(map[string]interface{})
+		if len(known) == 0 {
+			return nil
+		}
+
+		rules, err := current(client.withProject(d).fresh())
+		if err != nil {
+			return err
+		}
+
+		changed := changedRules(known, rules)
+		if len(changed) == 0 {
+			return nil
+		}
+		if d.Get("force_overwrite").(bool) {
+			return d.SetNew("rules_modified", rulesModified(rules))
+		}
+
+		return rulesChangedError(name, changed)
+	}
+}
+
+/*
+checkRulesUnchanged makes sure that nobody changed the policy's rules since
+Terraform last wrote them, so that updating the policy does not silently
+overwrite edits made in the Console between the plan and the apply.
+
+The current rules are read from the Console, bypassing the cache, and
+compared with the `rules_modified` baseline.  Rules added, removed or modified
+since then fail the update, unless `force_overwrite` is set.
+*/
+func (c *apiClient) checkRulesUnchanged(d *schema.ResourceData, name string, current policyRules) error {
+	if d.Get("force_overwrite").(bool) {
+		return nil
+	}
+
+	known, _ := d.Get("rules_modified").(map[string]interface{})
+	if len(known) == 0 {
+		logDebug("no rules_modified saved, not checking for changes", "policy", name)
+		return nil
+	}
+
+	rules, err := current(c.fresh())
+	if err != nil {
+		return err
+	}
+
+	if changed := changedRules(known, rules); len(changed) != 0 {
+		return rulesChangedError(name, changed)
+	}
+
+	return nil
+}
+
+func rulesChangedError(name string, changed []string) error {
+	return fmt.Errorf(
+		"The %s policy was changed outside of Terraform since Terraform last wrote it, in rules: %s.  "+
+			"Copy the changes to keep into the configuration, then set force_overwrite to overwrite the policy with it",
+		name, strings.Join(changed, ", "),
+	)
+}
+
+// changedRules returns the names of rules that were added, removed or
+// modified compared to the known modification times.
+func changedRules(known map[string]interface{}, rules []policy.Rule) []string {
+	var changed []string
+	seen := make(map[string]bool, len(rules))
+
+	for _, rule := range rules {
+		seen[rule.Name] = true
+		modified, ok := known[rule.Name]
+		if !ok {
+			changed = append(changed, fmt.Sprintf("%q (added)", rule.Name))
+		} else if modified != rule.Modified {
+			changed = append(changed, fmt.Sprintf("%q (modified %s)", rule.Name, rule.Modified))
+		}
+	}
+
+	for name := range known {
+		if !seen[name] {
+			changed = append(changed, fmt.Sprintf("%q (removed)", name))
+		}
+	}
+
+	sort.Strings(changed)
+	return changed
+}
@@ -0,0 +1,190 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestChangedRules(t *testing.T) {
+	known := map[string]interface{}{
+		"same":    "2021-06-01T00:00:00Z",
+		"edited":  "2021-06-01T00:00:00Z",
+		"deleted": "2021-06-01T00:00:00Z",
+	}
+	rules := []policy.Rule{
+		{Name: "same", Modified: "2021-06-01T00:00:00Z"},
+		{Name: "edited", Modified: "2021-06-02T00:00:00Z"},
+		{Name: "new", Modified: "2021-06-02T00:00:00Z"},
+	}
+
+	expected := []string{`"deleted" (removed)`, `"edited" (modified 2021-06-02T00:00:00Z)`, `"new" (added)`}
+	if got := changedRules(known, rules); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+	if got := changedRules(rulesModified(rules), rules); len(got) != 0 {
+		t.Errorf("got %q, expected no changes", got)
+	}
+}
+
+func TestCheckRulesUnchanged(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"force_overwrite": forceOverwriteSchema(),
+		"rules_modified":  rulesModifiedSchema(),
+	}
+	console := []policy.Rule{{Name: "rule", Modified: "2021-06-02T00:00:00Z"}}
+	current := func(*apiClient) ([]policy.Rule, error) { return console, nil }
+	client := newApiClient(nil)
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+	if err := client.checkRulesUnchanged(d, "runtime container", current); err != nil {
+		t.Errorf("without rules_modified: %s", err)
+	}
+
+	d.Set("rules_modified", map[string]interface{}{"rule": "2021-06-01T00:00:00Z"})
+	err := client.checkRulesUnchanged(d, "runtime container", current)
+	if err == nil || !strings.Contains(err.Error(), `"rule" (modified 2021-06-02T00:00:00Z)`) {
+		t.Errorf("expected a conflict naming the rule, got %v", err)
+	}
+
+	d.Set("rules_modified", rulesModified(console))
+	if err := client.checkRulesUnchanged(d, "runtime container", current); err != nil {
+		t.Errorf("unchanged: %s", err)
+	}
+
+	forced := schema.TestResourceDataRaw(t, s, map[string]interface{}{"force_overwrite": true})
+	forced.Set("rules_modified", map[string]interface{}{"rule": "2021-06-01T00:00:00Z"})
+	if err := client.checkRulesUnchanged(forced, "runtime container", current); err != nil {
+		t.Errorf("force_overwrite: %s", err)
+	}
+}
+
+func TestRulesChangedBetweenRefreshes(t *testing.T) {
+	modified := "2021-06-01T00:00:00Z"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/authenticate":
+			fmt.Fprint(w, `{"token": "opaque"}`)
+		case r.URL.Path == "/api/v1/policies/runtime/container" && r.Method == "GET":
+			fmt.Fprintf(w, `{"_id": "containerRuntime", "rules": [{"name": "rule", "modified": %q}]}`, modified)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := newApiClient(&pc.Client{
+		Url:      strings.TrimPrefix(srv.URL, "http://"),
+		Protocol: "http",
+		Username: "admin",
+		Password: "admin",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+
+	res := resourcePoliciesRuntimeContainer()
+	d := res.Data(nil)
+	d.SetId("containerRuntime")
+	if err := readPolicy(d, client); err != nil {
+		t.Fatal(err)
+	}
+	baseline := map[string]interface{}{"rule": "2021-06-01T00:00:00Z"}
+	if got := d.Get("rules_modified"); !reflect.DeepEqual(got, baseline) {
+		t.Fatalf("rules_modified after import is %v, expected %v", got, baseline)
+	}
+
+	// The rule is edited in the Console, then Terraform refreshes twice.
+	modified = "2021-06-02T00:00:00Z"
+	for i := 0; i < 2; i++ {
+		if err := readPolicy(d, client); err != nil {
+			t.Fatal(err)
+		}
+		if got := d.Get("rules_modified"); !reflect.DeepEqual(got, baseline) {
+			t.Fatalf("refresh %d replaced the baseline with %v", i, got)
+		}
+	}
+
+	if err := client.checkRulesUnchanged(d, "runtime container", policyRuntimeContainerRules); err == nil {
+		t.Errorf("Expected the update to notice the edited rule")
+	}
+
+	config := func(force bool) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"force_overwrite": force,
+			"rule": []interface{}{map[string]interface{}{
+				"name":   "rule",
+				"effect": "alert",
+			}},
+		})
+	}
+
+	_, err := res.Diff(d.State(), config(false), client)
+	if err == nil || !strings.Contains(err.Error(), `"rule" (modified 2021-06-02T00:00:00Z)`) {
+		t.Errorf("Expected the plan to fail naming the edited rule, got %v", err)
+	}
+
+	diff, err := res.Diff(d.State(), config(true), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attr, ok := diff.Attributes["rules_modified.rule"]; !ok || attr.New != modified {
+		t.Errorf("Expected force_overwrite to plan a new baseline, got %#v", attr)
+	}
+}
+
+func TestRuntimeHostRulesChangedAtPlan(t *testing.T) {
+	modified := "2021-06-01T00:00:00Z"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/authenticate":
+			fmt.Fprint(w, `{"token": "opaque"}`)
+		case strings.HasPrefix(r.URL.Path, "/api/v1/policies/runtime/") && r.Method == "GET":
+			fmt.Fprintf(w, `{"_id": "hostRuntime", "rules": [{"name": "rule", "modified": %q}]}`, modified)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := newApiClient(&pc.Client{
+		Url:      strings.TrimPrefix(srv.URL, "http://"),
+		Protocol: "http",
+		Username: "admin",
+		Password: "admin",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+
+	res := resourcePoliciesRuntimeHost()
+	d := res.Data(nil)
+	d.SetId("hostRuntime")
+	if err := res.Read(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"rules": []interface{}{map[string]interface{}{"name": "rule"}},
+	})
+	if _, err := res.Diff(d.State(), config, client); err != nil {
+		t.Errorf("Unchanged rules failed the plan: %s", err)
+	}
+
+	modified = "2021-06-02T00:00:00Z"
+	_, err := res.Diff(d.State(), config, client)
+	if err == nil || !strings.Contains(err.Error(), `"rule" (modified 2021-06-02T00:00:00Z)`) {
+		t.Errorf("Expected the plan to fail naming the edited rule, got %v", err)
+	}
+}
@@ -0,0 +1,31 @@
+package prismacloudcompute
+
+import (
+	"encoding/json"
+	"io/ioutil"
+)
+
+/*
+configFile holds the JSON config file settings that the provider applies
+itself instead of leaving them to the SDK, which would otherwise let them
+override the provider block.
+*/
+type configFile struct {
+	SkipSslCertVerification bool            `json:"skip_ssl_cert_verification"`
+	Logging                 map[string]bool `json:"logging"`
+}
+
+func readConfigFile(filename string) (configFile, error) {
+	var conf configFile
+	if filename == "" {
+		return conf, nil
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return conf, err
+	}
+
+	err = json.Unmarshal(b, &conf)
+	return conf, err
+}
@@ -0,0 +1,270 @@
+package prismacloudcompute
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+)
+
+/*
+The SDK connection prints every request it sends to stdout, including the
+Authorization header and the login body with the password, whatever the
+logging settings say.  So the client logs in and talks to the Console itself,
+following the SDK's conventions for paths, defaults and errors, and API calls
+are only logged by the loggingTransport, which redacts them.
+*/
+
+/*
+Initialize fills in the connection settings missing from the provider block
+from the given JSON config file, like the SDK does, then logs in unless the
+config file supplies a token.
+*/
+func (c *apiClient) Initialize(filename string) error {
+	var file pc.Client
+	if filename != "" {
+		b, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(b, &file); err != nil {
+			return err
+		}
+	}
+
+	if len(c.Logging) == 0 {
+		c.Logging = make(map[string]bool)
+		for key, val := range file.Logging {
+			c.Logging[key] = val
+		}
+		if len(c.Logging) == 0 {
+			c.Logging[pc.LogAction] = true
+		}
+	}
+
+	if c.Timeout == 0 {
+		c.Timeout = file.Timeout
+	}
+	if c.Timeout == 0 {
+		c.Timeout = 90
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("Invalid timeout")
+	}
+
+	if c.Port == 0 {
+		c.Port = file.Port
+	}
+	if c.Port > 65535 || c.Port < 0 {
+		return fmt.Errorf("Invalid port number")
+	}
+
+	if c.Protocol == "" {
+		c.Protocol = file.Protocol
+	}
+	if c.Protocol == "" {
+		c.Protocol = "https"
+	}
+	if c.Protocol != "http" && c.Protocol != "https" {
+		return fmt.Errorf("Invalid protocol")
+	}
+
+	if c.Url == "" {
+		c.Url = file.Url
+	}
+	if strings.HasPrefix(c.Url, "http://") || strings.HasPrefix(c.Url, "https://") {
+		return fmt.Errorf("Specify protocol using the Protocol param, not as the URL")
+	}
+	c.Url = strings.TrimRight(c.Url, "/")
+	if c.Url == "" {
+		return fmt.Errorf("Prisma Cloud URL is not set")
+	}
+
+	if c.Username == "" {
+		c.Username = file.Username
+	}
+	if c.Password == "" {
+		c.Password = file.Password
+	}
+
+	var transport http.RoundTripper = http.DefaultTransport
+	if c.Transport != nil {
+		transport = c.Transport
+	}
+	c.httpClient = &http.Client{
+		Transport: transport,
+		Timeout:   time.Duration(c.Timeout) * time.Second,
+	}
+
+	if c.token() == "" && file.JsonWebToken != "" {
+		c.setToken(file.JsonWebToken)
+		return nil
+	}
+
+	return c.Authenticate()
+}
+
+/*
+Authenticate logs in with the username and password, or extends the current
+token if there are none, and keeps the token returned.
+*/
+func (c *apiClient) Authenticate() error {
+	var ans pc.AuthResponse
+	var err error
+
+	if c.Username != "" && c.Password != "" {
+		c.Log(pc.LogAction, "(auth) retrieving jwt")
+		req := map[string]string{"username": c.Username, "password": c.Password}
+		_, err = c.communicate("POST", []string{"authenticate"}, nil, req, &ans, false)
+	} else if c.token() != "" {
+		c.Log(pc.LogAction, "(auth) refreshing jwt")
+		_, err = c.communicate("GET", []string{"auth_token", "extend"}, nil, nil, &ans, false)
+	} else {
+		return fmt.Errorf("no authentication params given")
+	}
+	if err != nil {
+		return err
+	}
+
+	c.setToken(ans.Token)
+	return nil
+}
+
+/*
+communicate sends one request to the Console's API and unmarshals the reply
+into ans, if given.
+
+A rejected token is renewed and the request sent again once, if allowRetry
+is set and reconnecting is not disabled.  Errors are reported the way the SDK
+reports them, so pc.ObjectNotFoundError and the like still apply.
+*/
+func (c *apiClient) communicate(method string, suffix []string, query, data interface{}, ans interface{}, allowRetry bool) ([]byte, error) {
+	if c.httpClient == nil {
+		return nil, fmt.Errorf("Connection to the Console is not initialized")
+	}
+
+	var body []byte
+	if data != nil {
+		var err error
+		if body, err = json.Marshal(data); err != nil {
+			return nil, err
+		}
+	}
+
+	var path strings.Builder
+	fmt.Fprintf(&path, "%s://%s", c.Protocol, c.Url)
+	if c.Port != 0 {
+		fmt.Fprintf(&path, ":%d", c.Port)
+	}
+	for _, v := range append([]string{"api", "v1"}, suffix...) {
+		path.WriteString("/")
+		path.WriteString(v)
+	}
+	if query != nil {
+		path.WriteString("?")
+		path.WriteString(query.(url.Values).Encode())
+	}
+
+	req, err := http.NewRequest(method, path.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if c.Logging[pc.LogPath] {
+		logInfo("api path", "method", method, "path", redactedPath(req.URL))
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if token := c.token(); token != "" && !c.noToken {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if c.noCache {
+		req.Header.Set("Cache-Control", "no-cache")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+	case http.StatusUnauthorized:
+		if !c.DisableReconnect && allowRetry {
+			if err = c.reauthenticate(); err == nil {
+				return c.communicate(method, suffix, query, data, ans, false)
+			}
+		}
+		return b, pc.InvalidCredentialsError
+	default:
+		errLocation := "X-Redlock-Status"
+		if _, ok := resp.Header[errLocation]; !ok {
+			return b, fmt.Errorf("%d error without the %q header - returned HTML:\n%s", resp.StatusCode, errLocation, b)
+		}
+		pcel := pc.PrismaCloudErrorList{
+			Method:     method,
+			StatusCode: resp.StatusCode,
+			Path:       redactedPath(req.URL),
+		}
+		info := resp.Header[errLocation][0]
+		if err = json.Unmarshal([]byte(info), &pcel.Errors); err != nil {
+			return b, fmt.Errorf("%d error, and could not unmarshal header %q: %s", resp.StatusCode, info, err)
+		}
+		if ce := pcel.GenericError(); ce != nil {
+			return b, ce
+		}
+		return b, pcel
+	}
+
+	if ans != nil {
+		if err = json.Unmarshal(b, ans); err != nil {
+			return b, err
+		}
+	}
+
+	return b, nil
+}
+
+// reauthenticate logs in again after the Console rejected the token.
+func (c *apiClient) reauthenticate() error {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	return c.Authenticate()
+}
+
+/*
+checkCredentials reports whether the Console accepts the given username and
+password.  The login is sent without the client's token and its answer is
+discarded, so the client stays logged in as itself.
+*/
+func (c *apiClient) checkCredentials(username, password string) (bool, error) {
+	cp := *c
+	cp.noToken = true
+
+	var query interface{}
+	if c.project != "" {
+		query = url.Values{"project": []string{c.project}}
+	}
+
+	req := map[string]string{"username": username, "password": password}
+	_, err := cp.communicate("POST", []string{"authenticate"}, query, req, nil, false)
+	switch err {
+	case nil:
+		return true, nil
+	case pc.InvalidCredentialsError:
+		return false, nil
+	}
+	return false, err
+}
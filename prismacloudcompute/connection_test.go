@@ -0,0 +1,195 @@
+package prismacloudcompute
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+)
+
+func TestConnectionPrintsNothing(t *testing.T) {
+	logins := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/authenticate":
+			logins++
+			fmt.Fprintf(w, `{"token": "token-%d"}`, logins)
+		case "/api/v1/collections":
+			if r.Header.Get("Authorization") != "Bearer token-2" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `[{"name": "All"}]`)
+		case "/api/v1/collections/missing":
+			w.Header().Set("X-Redlock-Status", `[{"i18nKey": "not_found", "severity": "error"}]`)
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	client := newApiClient(&pc.Client{
+		Url:      strings.TrimPrefix(srv.URL, "http://"),
+		Protocol: "http",
+		Username: "admin",
+		Password: "hunter2",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+
+	var ans []map[string]interface{}
+	if _, err := client.Communicate("GET", []string{"collections"}, nil, nil, &ans); err != nil {
+		t.Errorf("Rejected token was not renewed: %s", err)
+	} else if len(ans) != 1 || ans[0]["name"] != "All" {
+		t.Errorf("Got %v", ans)
+	}
+	if logins != 2 {
+		t.Errorf("Logged in %d times, expected 2", logins)
+	}
+
+	if _, err := client.Communicate("GET", []string{"collections", "missing"}, nil, nil, nil); err != pc.ObjectNotFoundError {
+		t.Errorf("Got error %v, expected %v", err, pc.ObjectNotFoundError)
+	}
+
+	w.Close()
+	os.Stdout = stdout
+	out, _ := ioutil.ReadAll(r)
+	if len(out) != 0 {
+		t.Errorf("Printed to stdout:\n%s", out)
+	}
+}
+
+func TestInitializeFromConfigFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	fmt.Fprint(f, `{"url": "console.example.com", "protocol": "http", "port": 8083, "timeout": 30, "json_web_token": "from-file"}`)
+	f.Close()
+
+	client := newApiClient(&pc.Client{Port: 8081})
+	if err := client.Initialize(f.Name()); err != nil {
+		t.Fatal(err)
+	}
+	if client.Url != "console.example.com" || client.Protocol != "http" || client.Port != 8081 || client.Timeout != 30 {
+		t.Errorf("Got %#v", client.Client)
+	}
+	if client.token() != "from-file" {
+		t.Errorf("Token is %q, expected the one from the file", client.token())
+	}
+
+	if err := newApiClient(&pc.Client{Url: "https://console.example.com"}).Initialize(""); err == nil {
+		t.Errorf("Expected a URL with the protocol to be rejected")
+	}
+}
+
+func TestTokenRenewedWhileInUse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/authenticate":
+			fmt.Fprint(w, `{"token": "opaque"}`)
+		case "/api/v1/collections":
+			fmt.Fprint(w, `[]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := newApiClient(&pc.Client{
+		Url:      strings.TrimPrefix(srv.URL, "http://"),
+		Protocol: "http",
+		Username: "admin",
+		Password: "admin",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+
+	// Per-project copies read the token while it is renewed, which the race
+	// detector checks.
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(project string) {
+			defer wg.Done()
+			cp := *client
+			cp.project = project
+			_, err := cp.Communicate("GET", []string{"collections"}, nil, nil, nil)
+			errs <- err
+		}(fmt.Sprintf("project-%d", i))
+		go func() {
+			defer wg.Done()
+			client.setToken("opaque")
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestCheckCredentialsKeepsToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/authenticate" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("Login was sent with %q", r.Header.Get("Authorization"))
+		}
+		var login map[string]string
+		json.NewDecoder(r.Body).Decode(&login)
+		if login["password"] != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintf(w, `{"token": "token-of-%s"}`, login["username"])
+	}))
+	defer srv.Close()
+
+	client := newApiClient(&pc.Client{
+		Url:      strings.TrimPrefix(srv.URL, "http://"),
+		Protocol: "http",
+		Username: "admin",
+		Password: "secret",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+
+	for password, expected := range map[string]bool{"secret": true, "wrong": false} {
+		if valid, err := client.checkCredentials("pipeline", password); err != nil || valid != expected {
+			t.Errorf("%s: got %t, %v, expected %t", password, valid, err, expected)
+		}
+	}
+	if client.token() != "token-of-admin" {
+		t.Errorf("Token is %q, expected the client's own", client.token())
+	}
+}
@@ -21,6 +21,22 @@ func IdToTwoStrings(v string) (string, string) {
 	return t[0], t[1]
 }
 
+// idPartEscaper escapes the separator, and the escape character itself, in
+// the parts of IDs that join strings which may contain the separator.
+var idPartEscaper = strings.NewReplacer("%", "%25", IdSeparator, "%3A")
+
+// idPartUnescaper only undoes idPartEscaper, so other percent signs in IDs
+// written by hand are kept as they are.
+var idPartUnescaper = strings.NewReplacer("%25", "%", "%3A", IdSeparator)
+
+func EscapeIdPart(v string) string {
+	return idPartEscaper.Replace(v)
+}
+
+func UnescapeIdPart(v string) string {
+	return idPartUnescaper.Replace(v)
+}
+
 func ListToStringSlice(v []interface{}) []string {
 	if len(v) == 0 {
 		return []string{}
@@ -0,0 +1,107 @@
+package prismacloudcompute
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// How long `credentials_command` may run.
+const credentialsCommandTimeout = time.Minute
+
+// externalCredentials is the JSON object `credentials_command` prints.
+type externalCredentials struct {
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key"`
+	Token     string `json:"token"`
+}
+
+/*
+runCredentialsCommand runs `credentials_command` with the system shell and
+parses the credentials it prints, such as:
+
+	{"username": "terraform", "password": "..."}
+
+Its output is never included in errors, as it holds secrets.
+*/
+func runCredentialsCommand(command string) (externalCredentials, error) {
+	var creds externalCredentials
+
+	ctx, cancel := context.WithTimeout(context.Background(), credentialsCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return creds, fmt.Errorf("credentials_command failed: %s: %s", err, msg)
+		}
+		return creds, fmt.Errorf("credentials_command failed: %s", err)
+	}
+
+	if err := json.Unmarshal(out, &creds); err != nil {
+		return creds, fmt.Errorf("credentials_command did not print a JSON object of credentials")
+	}
+	if creds.Token == "" && (creds.Username == "" || creds.Password == "") && (creds.AccessKey == "" || creds.SecretKey == "") {
+		return creds, fmt.Errorf("credentials_command printed neither username / password, access_key / secret_key, nor token")
+	}
+
+	return creds, nil
+}
+
+/*
+tokenFile is a `token_file` holding a JSON web token that something else,
+such as a secrets agent, keeps rotating.  It is re-read whenever its
+modification time or size changes.
+*/
+type tokenFile struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+}
+
+// read returns the token if the file changed since the last read.
+func (f *tokenFile) read() (string, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return "", false, fmt.Errorf("Error reading token_file: %s", err)
+	}
+	if info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return "", false, nil
+	}
+
+	b, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return "", false, fmt.Errorf("Error reading token_file: %s", err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", false, fmt.Errorf("token_file %q is empty", f.path)
+	}
+
+	f.modTime, f.size = info.ModTime(), info.Size()
+	return token, true, nil
+}
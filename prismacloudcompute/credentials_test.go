@@ -0,0 +1,112 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+)
+
+func TestRunCredentialsCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh syntax")
+	}
+
+	creds, err := runCredentialsCommand(`printf '{"username": "terraform", "password": "s3cret"}'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Username != "terraform" || creds.Password != "s3cret" {
+		t.Errorf("got %#v", creds)
+	}
+
+	cases := []struct {
+		command, expected string
+	}{
+		{`echo "vault is sealed" >&2; exit 3`, "exit status 3: vault is sealed"},
+		{`echo s3cret`, "did not print a JSON object"},
+		{`echo '{"username": "terraform"}'`, "printed neither"},
+	}
+	for _, tc := range cases {
+		_, err := runCredentialsCommand(tc.command)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("%s: got error %v, expected it to contain %q", tc.command, err, tc.expected)
+		} else if strings.Contains(err.Error(), "s3cret") {
+			t.Errorf("%s: error leaks the command's output: %s", tc.command, err)
+		}
+	}
+}
+
+func TestTokenFileRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token")
+
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if r.URL.Path == "/api/v1/auth_token/extend" {
+			fmt.Fprintf(w, `{"token": %q}`, token)
+			return
+		}
+		seen = append(seen, token)
+		fmt.Fprint(w, "[]")
+	}))
+	defer srv.Close()
+
+	rotate := func(token string, age time.Duration) {
+		if err := ioutil.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		mtime := time.Now().Add(-age)
+		os.Chtimes(path, mtime, mtime)
+	}
+	rotate("first", time.Hour)
+
+	tokens := &tokenFile{path: path}
+	token, _, err := tokens.read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newApiClient(&pc.Client{
+		Url:          strings.TrimPrefix(srv.URL, "http://"),
+		Protocol:     "http",
+		JsonWebToken: token,
+		Logging:      map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+	client.tokenFile = tokens
+
+	var ans []interface{}
+	for i := 0; i < 2; i++ {
+		if _, err := client.Communicate("GET", []string{"collections"}, nil, nil, &ans); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rotate("second", 0)
+	if _, err := client.Communicate("GET", []string{"collections"}, nil, nil, &ans); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []string{"first", "first", "second"}; strings.Join(seen, ",") != strings.Join(expected, ",") {
+		t.Errorf("got tokens %q, expected %q", seen, expected)
+	}
+
+	os.Remove(path)
+	if _, err := client.Communicate("GET", []string{"collections"}, nil, nil, &ans); err == nil {
+		t.Errorf("expected an error once the token file is gone")
+	}
+}
@@ -3,9 +3,7 @@ package prismacloudcompute
 import (
 	"bytes"
 	"encoding/base64"
-	"log"
 
-	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/collection"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -16,6 +14,7 @@ func dataSourceCollections() *schema.Resource {
 		Read: dataSourceCollectionsRead,
 
 		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
 
 			// Output.
 			"accountids": {
@@ -139,7 +138,7 @@ func dataSourceCollections() *schema.Resource {
 
 func dataSourceCollectionsRead(d *schema.ResourceData, meta interface{}) error {
 	var buf bytes.Buffer
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	items, err := collection.List(client)
 	if err != nil {
@@ -177,7 +176,7 @@ func dataSourceCollectionsRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("listing", list); err != nil {
-		log.Printf("[WARN] Error setting 'listing' field for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "listing", "id", d.Id(), "error", err)
 	}
 
 	return nil
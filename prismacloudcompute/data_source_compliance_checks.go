@@ -0,0 +1,157 @@
+package prismacloudcompute
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/complianceCheck"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/customCompliance"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceComplianceChecks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComplianceChecksRead,
+
+		Schema: map[string]*schema.Schema{
+			// Input.
+			"project": projectSchema(),
+			"template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return checks in this compliance template, such as 'CIS Docker', 'CIS Kubernetes', 'DISA STIG', 'GDPR', 'HIPAA', 'NIST SP 800-190', or 'PCI'.",
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return checks with this severity.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return checks of this type, such as 'container', 'image', 'daemonConfig', or 'custom'.",
+			},
+
+			// Output.
+			"total": totalSchema("compliance checks"),
+			"checks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of compliance checks, ordered by ID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the check, as used in compliance rule conditions.",
+						},
+						"title": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Title of the check.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the check.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the check.",
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Severity of the check.",
+						},
+						"templates": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Compliance templates the check is part of.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// listComplianceChecks returns the built-in and custom compliance checks,
+// ordered by ID.
+func listComplianceChecks(client *apiClient) ([]complianceCheck.Check, error) {
+	checks, err := complianceCheck.List(client)
+	if err != nil {
+		return nil, err
+	}
+
+	custom, err := customCompliance.List(client)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool, len(checks))
+	for _, v := range checks {
+		seen[v.Id] = true
+	}
+	for _, v := range custom {
+		if seen[v.Id] {
+			continue
+		}
+		checks = append(checks, complianceCheck.Check{
+			Id:       v.Id,
+			Title:    v.Title,
+			Type:     complianceCheck.TypeCustom,
+			Severity: v.Severity,
+		})
+	}
+
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Id < checks[j].Id })
+	return checks, nil
+}
+
+func dataSourceComplianceChecksRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	template := d.Get("template").(string)
+	severity := d.Get("severity").(string)
+	checkType := d.Get("type").(string)
+
+	items, err := listComplianceChecks(client)
+	if err != nil {
+		return err
+	}
+
+	list := make([]interface{}, 0, len(items))
+	for _, i := range items {
+		if template != "" && !i.InTemplate(template) {
+			continue
+		}
+		if severity != "" && !strings.EqualFold(i.Severity, severity) {
+			continue
+		}
+		if checkType != "" && !strings.EqualFold(i.Type, checkType) {
+			continue
+		}
+		list = append(list, map[string]interface{}{
+			"id":          i.Id,
+			"title":       i.Title,
+			"description": i.Description,
+			"type":        i.Type,
+			"severity":    i.Severity,
+			"templates":   i.Templates,
+		})
+	}
+
+	d.SetId(strings.Join([]string{template, severity, checkType}, IdSeparator))
+	d.Set("total", len(list))
+
+	if err := d.Set("checks", list); err != nil {
+		logWarn("error setting field", "field", "checks", "id", d.Id(), "error", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package prismacloudcompute
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDsComplianceChecks(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsComplianceChecksConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.prismacloudcompute_compliance_checks.test", "total"),
+					resource.TestCheckResourceAttrSet("data.prismacloudcompute_compliance_checks.test", "checks.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDsComplianceChecksConfig() string {
+	return `
+data "prismacloudcompute_compliance_checks" "test" {
+    template = "CIS Docker"
+    severity = "high"
+}
+`
+}
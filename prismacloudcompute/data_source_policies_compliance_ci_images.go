@@ -1,9 +1,6 @@
 package prismacloudcompute
 
 import (
-	"log"
-
-	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyComplianceCiImages"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -15,6 +12,7 @@ func dataSourcePoliciesComplianceCiImages() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			// Input.
+			"project": projectSchema(),
 			"filters": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -525,7 +523,7 @@ func dataSourcePoliciesComplianceCiImages() *schema.Resource {
 }
 
 func dataSourcePoliciesComplianceCiImagesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	i, err := policyComplianceCiImages.Get(client)
 	if err != nil {
@@ -542,7 +540,7 @@ func dataSourcePoliciesComplianceCiImagesRead(d *schema.ResourceData, meta inter
 	})
 
 	if err := d.Set("listing", list); err != nil {
-		log.Printf("[WARN] Error setting 'listing' field for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "listing", "id", d.Id(), "error", err)
 	}
 
 	return nil
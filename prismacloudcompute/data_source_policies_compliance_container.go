@@ -1,9 +1,6 @@
 package prismacloudcompute
 
 import (
-	"log"
-
-	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyComplianceContainer"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -15,6 +12,7 @@ func dataSourcePoliciesComplianceContainer() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			// Input.
+			"project": projectSchema(),
 			"filters": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -525,7 +523,7 @@ func dataSourcePoliciesComplianceContainer() *schema.Resource {
 }
 
 func dataSourcePoliciesComplianceContainerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	i, err := policyComplianceContainer.Get(client)
 	if err != nil {
@@ -542,7 +540,7 @@ func dataSourcePoliciesComplianceContainerRead(d *schema.ResourceData, meta inte
 	})
 
 	if err := d.Set("listing", list); err != nil {
-		log.Printf("[WARN] Error setting 'listing' field for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "listing", "id", d.Id(), "error", err)
 	}
 
 	return nil
@@ -1,9 +1,6 @@
 package prismacloudcompute
 
 import (
-	"log"
-
-	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyRuntimeContainer"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -15,6 +12,7 @@ func dataSourcePoliciesRuntimeContainer() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			// Input.
+			"project": projectSchema(),
 			"filters": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -561,7 +559,7 @@ func dataSourcePoliciesRuntimeContainer() *schema.Resource {
 }
 
 func dataSourcePoliciesRuntimeContainerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	i, err := policyRuntimeContainer.Get(client)
 	if err != nil {
@@ -578,7 +576,7 @@ func dataSourcePoliciesRuntimeContainerRead(d *schema.ResourceData, meta interfa
 	})
 
 	if err := d.Set("listing", list); err != nil {
-		log.Printf("[WARN] Error setting 'listing' field for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "listing", "id", d.Id(), "error", err)
 	}
 
 	return nil
@@ -1,9 +1,6 @@
 package prismacloudcompute
 
 import (
-	"log"
-
-	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyRuntimeHost"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -15,6 +12,7 @@ func dataSourcePoliciesRuntimeHost() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			// Input.
+			"project": projectSchema(),
 			"filters": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -662,7 +660,7 @@ func dataSourcePoliciesRuntimeHost() *schema.Resource {
 }
 
 func dataSourcePoliciesRuntimeHostRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	i, err := policyRuntimeHost.Get(client)
 	if err != nil {
@@ -679,7 +677,7 @@ func dataSourcePoliciesRuntimeHostRead(d *schema.ResourceData, meta interface{})
 	})
 
 	if err := d.Set("listing", list); err != nil {
-		log.Printf("[WARN] Error setting 'listing' field for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "listing", "id", d.Id(), "error", err)
 	}
 
 	return nil
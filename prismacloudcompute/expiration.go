@@ -0,0 +1,233 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+/*
+CVE rule and tag exceptions carry an `expiration` map with a `date` and an
+`enabled` flag.  The date must be RFC3339 (for example 2021-12-31T00:00:00Z),
+which is what the Console itself stores.
+*/
+
+// validateExceptionExpiration is the ValidateFunc for an exception's
+// `expiration` map.  Exceptions that already expired are reported as warnings,
+// which Terraform shows on validate and plan.
+func validateExceptionExpiration(v interface{}, k string) (ws []string, es []error) {
+	return checkExceptionExpiration(v, k, time.Now(), 0)
+}
+
+// checkExceptionExpiration validates an `expiration` map and warns about an
+// exception that expired or expires within the given number of days.
+func checkExceptionExpiration(v interface{}, k string, now time.Time, days int) (ws []string, es []error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, []error{fmt.Errorf("expected %q to be a map", k)}
+	}
+
+	for key := range m {
+		if key != "date" && key != "enabled" {
+			es = append(es, fmt.Errorf("%s: unsupported key %q, expected \"date\" or \"enabled\"", k, key))
+		}
+	}
+
+	exp, err := parseExpiration(m)
+	if err != nil {
+		return ws, append(es, fmt.Errorf("%s: %s", k, err))
+	}
+
+	if expired(exp, now) {
+		ws = append(ws, fmt.Sprintf("%s: exception expired on %s", k, exp.Date))
+	} else if expired(exp, now.AddDate(0, 0, days)) {
+		ws = append(ws, fmt.Sprintf("%s: exception expires on %s, within %d days", k, exp.Date, days))
+	}
+
+	return ws, es
+}
+
+// parseExpiration converts an `expiration` map into its SDK struct.
+func parseExpiration(m map[string]interface{}) (policy.Expiration, error) {
+	var ans policy.Expiration
+
+	if v, ok := m["date"]; ok && v != nil {
+		ans.Date = fmt.Sprint(v)
+		if ans.Date != "" {
+			if _, err := time.Parse(time.RFC3339, ans.Date); err != nil {
+				return ans, fmt.Errorf("date %q is not a valid RFC3339 date", ans.Date)
+			}
+		}
+	}
+
+	switch v := m["enabled"].(type) {
+	case bool:
+		ans.Enabled = v
+	case string:
+		if v != "" {
+			enbl, err := strconv.ParseBool(v)
+			if err != nil {
+				return ans, fmt.Errorf("enabled %q is not a boolean", v)
+			}
+			ans.Enabled = enbl
+		}
+	}
+
+	return ans, nil
+}
+
+func parseCveRules(items []interface{}) []policy.CveRule {
+	ans := make([]policy.CveRule, 0, len(items))
+	for _, v := range items {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		cveRule := policy.CveRule{
+			Description: item["description"].(string),
+			Effect:      item["effect"].(string),
+			Id:          item["id"].(string),
+		}
+		if m, ok := item["expiration"].(map[string]interface{}); ok {
+			cveRule.Expiration, _ = parseExpiration(m)
+		}
+		ans = append(ans, cveRule)
+	}
+
+	return ans
+}
+
+func parseTags(items []interface{}) []policy.Tag {
+	ans := make([]policy.Tag, 0, len(items))
+	for _, v := range items {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		tag := policy.Tag{
+			Description: item["description"].(string),
+			Effect:      item["effect"].(string),
+			Name:        item["name"].(string),
+		}
+		if m, ok := item["expiration"].(map[string]interface{}); ok && len(m) != 0 {
+			exp, _ := parseExpiration(m)
+			tag.Expiration = []policy.Expiration{exp}
+		}
+		ans = append(ans, tag)
+	}
+
+	return ans
+}
+
+// expired reports whether an enabled expiration is at or before now.
+func expired(exp policy.Expiration, now time.Time) bool {
+	if !exp.Enabled || exp.Date == "" {
+		return false
+	}
+
+	date, err := time.Parse(time.RFC3339, exp.Date)
+	return err == nil && !date.After(now)
+}
+
+// withoutExpiredExceptions returns the rules with their expired CVE rule and
+// tag exceptions removed.
+func withoutExpiredExceptions(rules []policy.Rule, now time.Time) []policy.Rule {
+	ans := make([]policy.Rule, 0, len(rules))
+	for _, rule := range rules {
+		cveRules := make([]policy.CveRule, 0, len(rule.CveRules))
+		for _, cveRule := range rule.CveRules {
+			if expired(cveRule.Expiration, now) {
+				logInfo("dropping expired exception", "cve", cveRule.Id, "rule", rule.Name)
+				continue
+			}
+			cveRules = append(cveRules, cveRule)
+		}
+		rule.CveRules = cveRules
+
+		tags := make([]policy.Tag, 0, len(rule.Tags))
+		for _, tag := range rule.Tags {
+			if len(tag.Expiration) != 0 && expired(tag.Expiration[0], now) {
+				logInfo("dropping expired exception", "tag", tag.Name, "rule", rule.Name)
+				continue
+			}
+			tags = append(tags, tag)
+		}
+		rule.Tags = tags
+
+		ans = append(ans, rule)
+	}
+
+	return ans
+}
+
+// activeExceptions applies the provider's drop_expired_exceptions setting to
+// the rules about to be sent to the Console.
+func (c *apiClient) activeExceptions(rules []policy.Rule) []policy.Rule {
+	if !c.dropExpiredExceptions {
+		return rules
+	}
+
+	return withoutExpiredExceptions(rules, time.Now())
+}
+
+/*
+customizeExceptionExpiration returns a CustomizeDiff function that warns about
+CVE rule and tag exceptions in the `key` rule list that expire within the
+provider's exception_expiration_warning_days.  Validation has no access to the
+provider settings, so it only reports the exceptions that already expired.
+*/
+func customizeExceptionExpiration(key string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*apiClient)
+		if !ok || client.exceptionWarningDays == 0 {
+			return nil
+		}
+
+		now := time.Now()
+		check := func(rule, kind, name string, v interface{}) {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return
+			}
+			if exp, err := parseExpiration(m); err != nil || expired(exp, now) {
+				return
+			}
+			k := fmt.Sprintf("rule %q: %s exception %q", rule, kind, name)
+			ws, _ := checkExceptionExpiration(m, k, now, client.exceptionWarningDays)
+			for _, msg := range ws {
+				logWarn(msg)
+			}
+		}
+
+		rules, _ := d.Get(key).([]interface{})
+		for _, v := range rules {
+			rule, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := rule["name"].(string)
+
+			cveRules, _ := rule["cverules"].([]interface{})
+			for _, cr := range cveRules {
+				if item, ok := cr.(map[string]interface{}); ok {
+					check(name, "CVE", fmt.Sprint(item["id"]), item["expiration"])
+				}
+			}
+
+			tags, _ := rule["tags"].([]interface{})
+			for _, t := range tags {
+				if item, ok := t.(map[string]interface{}); ok {
+					check(name, "tag", fmt.Sprint(item["name"]), item["expiration"])
+				}
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,96 @@
+package prismacloudcompute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
+)
+
+func TestValidateExceptionExpiration(t *testing.T) {
+	future := time.Now().AddDate(1, 0, 0).Format(time.RFC3339)
+
+	cases := []struct {
+		name     string
+		value    map[string]interface{}
+		warnings int
+		errors   int
+	}{
+		{"future", map[string]interface{}{"date": future, "enabled": "true"}, 0, 0},
+		{"expired", map[string]interface{}{"date": "2020-01-01T00:00:00Z", "enabled": "true"}, 1, 0},
+		{"expired but disabled", map[string]interface{}{"date": "2020-01-01T00:00:00Z", "enabled": "false"}, 0, 0},
+		{"not rfc3339", map[string]interface{}{"date": "2020-01-01", "enabled": "true"}, 0, 1},
+		{"bad enabled", map[string]interface{}{"date": future, "enabled": "yes please"}, 0, 1},
+		{"unknown key", map[string]interface{}{"date": future, "expires": future}, 0, 1},
+		{"empty", map[string]interface{}{}, 0, 0},
+	}
+
+	for _, tc := range cases {
+		ws, es := validateExceptionExpiration(tc.value, "expiration")
+		if len(ws) != tc.warnings || len(es) != tc.errors {
+			t.Errorf("%s: got %d warnings %v and %d errors %v, expected %d and %d", tc.name, len(ws), ws, len(es), es, tc.warnings, tc.errors)
+		}
+	}
+}
+
+func TestWithoutExpiredExceptions(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	rules := []policy.Rule{{
+		Name: "rule",
+		CveRules: []policy.CveRule{
+			{Id: "CVE-1", Expiration: policy.Expiration{Date: "2021-05-01T00:00:00Z", Enabled: true}},
+			{Id: "CVE-2", Expiration: policy.Expiration{Date: "2021-07-01T00:00:00Z", Enabled: true}},
+			{Id: "CVE-3", Expiration: policy.Expiration{Date: "2021-05-01T00:00:00Z", Enabled: false}},
+			{Id: "CVE-4"},
+		},
+		Tags: []policy.Tag{
+			{Name: "old", Expiration: []policy.Expiration{{Date: "2021-05-01T00:00:00Z", Enabled: true}}},
+			{Name: "new", Expiration: []policy.Expiration{{Date: "2021-07-01T00:00:00Z", Enabled: true}}},
+		},
+	}}
+
+	ans := withoutExpiredExceptions(rules, now)
+
+	var ids []string
+	for _, cveRule := range ans[0].CveRules {
+		ids = append(ids, cveRule.Id)
+	}
+	if len(ids) != 3 || ids[0] != "CVE-2" || ids[1] != "CVE-3" || ids[2] != "CVE-4" {
+		t.Errorf("Kept CVE rules %v, expected [CVE-2 CVE-3 CVE-4]", ids)
+	}
+	if len(ans[0].Tags) != 1 || ans[0].Tags[0].Name != "new" {
+		t.Errorf("Kept tags %v, expected only \"new\"", ans[0].Tags)
+	}
+	if len(rules[0].CveRules) != 4 {
+		t.Errorf("Input rules were modified")
+	}
+}
+
+func TestCheckExceptionExpiration(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		date     string
+		enabled  string
+		days     int
+		warnings int
+	}{
+		{"2021-05-01T00:00:00Z", "true", 14, 1},
+		{"2021-06-05T00:00:00Z", "true", 14, 1},
+		{"2021-06-15T00:00:00Z", "true", 14, 1},
+		{"2021-06-16T00:00:00Z", "true", 14, 0},
+		{"2021-09-01T00:00:00Z", "true", 14, 0},
+		{"2021-06-05T00:00:00Z", "false", 14, 0},
+		{"2021-05-01T00:00:00Z", "true", 0, 1},
+		{"2021-06-05T00:00:00Z", "true", 0, 0},
+		{"2021-06-16T00:00:00Z", "true", 30, 1},
+	}
+
+	for _, tc := range cases {
+		value := map[string]interface{}{"date": tc.date, "enabled": tc.enabled}
+		ws, es := checkExceptionExpiration(value, "expiration", now, tc.days)
+		if len(ws) != tc.warnings || len(es) != 0 {
+			t.Errorf("%s (enabled %s, %d days): got warnings %v and errors %v, expected %d warnings", tc.date, tc.enabled, tc.days, ws, es, tc.warnings)
+		}
+	}
+}
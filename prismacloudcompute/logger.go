@@ -0,0 +1,66 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+/*
+The provider logs structured lines through Terraform's log, which filters them
+by the level in brackets (TF_LOG):
+
+	[DEBUG] prismacloudcompute: api call: method=GET path=/api/v1/policies status=200
+
+Fields are given as alternating keys and values, and keep their order.
+*/
+
+// logRaw is a field value written as is, such as a JSON body, which is best
+// left last on the line.
+type logRaw string
+
+func logTrace(msg string, fields ...interface{}) { writeLog("TRACE", msg, fields) }
+func logDebug(msg string, fields ...interface{}) { writeLog("DEBUG", msg, fields) }
+func logInfo(msg string, fields ...interface{})  { writeLog("INFO", msg, fields) }
+func logWarn(msg string, fields ...interface{})  { writeLog("WARN", msg, fields) }
+
+func writeLog(level, msg string, fields []interface{}) {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "[%s] prismacloudcompute: %s", level, msg)
+
+	for i := 0; i < len(fields); i += 2 {
+		if i == 0 {
+			buf.WriteString(":")
+		}
+		var val interface{} = "<missing>"
+		if i+1 < len(fields) {
+			val = fields[i+1]
+		}
+		fmt.Fprintf(&buf, " %v=%s", fields[i], logValue(val))
+	}
+
+	log.Print(buf.String())
+}
+
+// logValue formats a field value, quoting it when it would not read as a
+// single token.
+func logValue(v interface{}) string {
+	var s string
+	switch val := v.(type) {
+	case logRaw:
+		return string(val)
+	case error:
+		s = val.Error()
+	case fmt.Stringer:
+		s = val.String()
+	case string:
+		s = val
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		return fmt.Sprintf("%q", s)
+	}
+	return s
+}
@@ -0,0 +1,38 @@
+package prismacloudcompute
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteLog(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	logDebug("api call", "method", "GET", "path", "/api/v1/policies", "status", 200, "latency", 15*time.Millisecond)
+	logWarn("error setting field", "field", "rule", "id", "", "error", errors.New("bad value"))
+	logTrace("api response", "body", logRaw(`{"name":"a b"}`))
+	logInfo("done")
+
+	expected := []string{
+		`[DEBUG] prismacloudcompute: api call: method=GET path=/api/v1/policies status=200 latency=15ms`,
+		`[WARN] prismacloudcompute: error setting field: field=rule id="" error="bad value"`,
+		`[TRACE] prismacloudcompute: api response: body={"name":"a b"}`,
+		`[INFO] prismacloudcompute: done`,
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != len(expected) {
+		t.Fatalf("got %d lines, expected %d:\n%s", len(lines), len(expected), buf.String())
+	}
+	for i, line := range lines {
+		if !strings.HasSuffix(line, expected[i]) {
+			t.Errorf("got %s, expected %s", line, expected[i])
+		}
+	}
+}
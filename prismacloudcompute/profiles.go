@@ -0,0 +1,228 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"gopkg.in/yaml.v2"
+)
+
+// Profile used when neither `profile` nor the file's `default_profile` is set.
+const defaultProfile = "default"
+
+/*
+Provider settings that a profile may hold, and their types.  The names are the
+same as the provider attributes.
+*/
+var profileKeys = map[string]schema.ValueType{
+	"url":                        schema.TypeString,
+	"region":                     schema.TypeString,
+	"tenant":                     schema.TypeString,
+	"project":                    schema.TypeString,
+	"username":                   schema.TypeString,
+	"password":                   schema.TypeString,
+	"access_key":                 schema.TypeString,
+	"secret_key":                 schema.TypeString,
+	"json_web_token":             schema.TypeString,
+	"credentials_command":        schema.TypeString,
+	"token_file":                 schema.TypeString,
+	"protocol":                   schema.TypeString,
+	"port":                       schema.TypeInt,
+	"timeout":                    schema.TypeInt,
+	"skip_ssl_cert_verification": schema.TypeBool,
+	"disable_reconnect":          schema.TypeBool,
+	"ca_cert_file":               schema.TypeString,
+	"ca_cert_pem":                schema.TypeString,
+	"client_cert":                schema.TypeString,
+	"client_key":                 schema.TypeString,
+	"min_tls_version":            schema.TypeString,
+	"proxy_url":                  schema.TypeString,
+	"no_proxy":                   schema.TypeString,
+	"proxy_username":             schema.TypeString,
+	"proxy_password":             schema.TypeString,
+	"custom_headers":             schema.TypeMap,
+	"logging":                    schema.TypeMap,
+	"max_requests_per_second":    schema.TypeInt,
+	"max_concurrent_requests":    schema.TypeInt,
+}
+
+var profileTypeNames = map[schema.ValueType]string{
+	schema.TypeString: "a string",
+	schema.TypeInt:    "an integer",
+	schema.TypeBool:   "true or false",
+	schema.TypeMap:    "a map",
+}
+
+// ${NAME} references to environment variables, and $$ for a literal $.
+var interpolation = regexp.MustCompile(`\$\$|\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+/*
+loadProfile reads the named profile from a `config_file`, in JSON or YAML:
+
+	default_profile: prod
+	profiles:
+	  prod:
+	    url: console.example.com
+	    username: terraform
+	    password: ${PROD_PASSWORD}
+
+String values may reference environment variables as ${NAME}.
+*/
+func loadProfile(filename, name string) (map[string]interface{}, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading config_file: %s", err)
+	}
+
+	// YAML is a superset of JSON, so this reads both.
+	var file struct {
+		DefaultProfile string                            `yaml:"default_profile"`
+		Profiles       map[string]map[string]interface{} `yaml:"profiles"`
+	}
+	if err := yaml.UnmarshalStrict(b, &file); err != nil {
+		return nil, fmt.Errorf("Error parsing config_file %q: %s", filename, err)
+	}
+
+	if name == "" {
+		name = file.DefaultProfile
+	}
+	if name == "" {
+		name = defaultProfile
+	}
+
+	raw, ok := file.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(file.Profiles))
+		for key := range file.Profiles {
+			names = append(names, key)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("Profile %q not found in config_file %q, it has: %s", name, filename, strings.Join(names, ", "))
+	}
+
+	prof := make(map[string]interface{}, len(raw))
+	for key, val := range raw {
+		if prof[key], err = profileValue(key, val); err != nil {
+			return nil, fmt.Errorf("Profile %q: %s", name, err)
+		}
+	}
+
+	return prof, nil
+}
+
+// profileValue checks the type of a profile setting and interpolates
+// environment variables into its strings.
+func profileValue(key string, val interface{}) (interface{}, error) {
+	vt, ok := profileKeys[key]
+	if !ok {
+		return nil, fmt.Errorf("unknown setting %q", key)
+	}
+
+	switch vt {
+	case schema.TypeString:
+		if s, ok := val.(string); ok {
+			return interpolate(key, s)
+		}
+	case schema.TypeInt:
+		if i, ok := val.(int); ok {
+			return i, nil
+		}
+	case schema.TypeBool:
+		if b, ok := val.(bool); ok {
+			return b, nil
+		}
+	case schema.TypeMap:
+		m, ok := val.(map[interface{}]interface{})
+		if !ok {
+			break
+		}
+		ans := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			name := fmt.Sprintf("%v", k)
+			switch v := v.(type) {
+			case string:
+				s, err := interpolate(key, v)
+				if err != nil {
+					return nil, err
+				}
+				ans[name] = s
+			case bool:
+				ans[name] = v
+			default:
+				return nil, fmt.Errorf("%s: unexpected value for %q", key, name)
+			}
+		}
+		return ans, nil
+	}
+
+	return nil, fmt.Errorf("%s: expected %s", key, profileTypeNames[vt])
+}
+
+func interpolate(key, s string) (string, error) {
+	var err error
+	ans := interpolation.ReplaceAllStringFunc(s, func(ref string) string {
+		if ref == "$$" {
+			return "$"
+		}
+		name := ref[2 : len(ref)-1]
+		val, ok := os.LookupEnv(name)
+		if !ok && err == nil {
+			err = fmt.Errorf("%s: environment variable %s is not set", key, name)
+		}
+		return val
+	})
+
+	return ans, err
+}
+
+/*
+providerSettings reads provider settings from the provider block, falling
+back to the selected profile for settings that are not set there (or in their
+environment variables).
+*/
+type providerSettings struct {
+	d       *schema.ResourceData
+	profile map[string]interface{}
+}
+
+func (s providerSettings) String(key string) string {
+	if v := s.d.Get(key).(string); v != "" {
+		return v
+	}
+
+	v, _ := s.profile[key].(string)
+	return v
+}
+
+func (s providerSettings) Int(key string) int {
+	if v := s.d.Get(key).(int); v != 0 {
+		return v
+	}
+
+	v, _ := s.profile[key].(int)
+	return v
+}
+
+// Bool returns the setting and whether it was set anywhere.
+func (s providerSettings) Bool(key string) (bool, bool) {
+	if v, ok := s.d.GetOkExists(key); ok {
+		return v.(bool), true
+	}
+
+	v, ok := s.profile[key].(bool)
+	return v, ok
+}
+
+func (s providerSettings) Map(key string) map[string]interface{} {
+	if v := s.d.Get(key).(map[string]interface{}); len(v) > 0 {
+		return v
+	}
+
+	v, _ := s.profile[key].(map[string]interface{})
+	return v
+}
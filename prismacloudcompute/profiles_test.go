@@ -0,0 +1,145 @@
+package prismacloudcompute
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func writeConfigFile(t *testing.T, dir, name, content string) string {
+	filename := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestLoadProfile(t *testing.T) {
+	os.Setenv("PRISMACLOUDCOMPUTE_TEST_PASSWORD", "s3cret")
+	defer os.Unsetenv("PRISMACLOUDCOMPUTE_TEST_PASSWORD")
+
+	dir, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	yamlFile := writeConfigFile(t, dir, "config.yaml", `
+default_profile: prod
+profiles:
+  prod:
+    url: prod.example.com
+    username: terraform
+    password: ${PRISMACLOUDCOMPUTE_TEST_PASSWORD}
+    port: 8083
+    skip_ssl_cert_verification: false
+    custom_headers:
+      X-Cost: $$5
+  dev:
+    url: dev.example.com
+    logging:
+      quiet: true
+`)
+	jsonFile := writeConfigFile(t, dir, "config.json", `{
+  "profiles": {
+    "default": {"url": "json.example.com", "timeout": 30}
+  }
+}`)
+
+	cases := []struct {
+		filename, profile string
+		expected          map[string]interface{}
+	}{
+		{yamlFile, "", map[string]interface{}{
+			"url":                        "prod.example.com",
+			"username":                   "terraform",
+			"password":                   "s3cret",
+			"port":                       8083,
+			"skip_ssl_cert_verification": false,
+			"custom_headers":             map[string]interface{}{"X-Cost": "$5"},
+		}},
+		{yamlFile, "dev", map[string]interface{}{
+			"url":     "dev.example.com",
+			"logging": map[string]interface{}{"quiet": true},
+		}},
+		{jsonFile, "", map[string]interface{}{
+			"url":     "json.example.com",
+			"timeout": 30,
+		}},
+	}
+
+	for _, tc := range cases {
+		prof, err := loadProfile(tc.filename, tc.profile)
+		if err != nil {
+			t.Errorf("%s %q: %s", filepath.Base(tc.filename), tc.profile, err)
+		} else if !reflect.DeepEqual(prof, tc.expected) {
+			t.Errorf("%s %q: got %#v, expected %#v", filepath.Base(tc.filename), tc.profile, prof, tc.expected)
+		}
+	}
+}
+
+func TestLoadProfileErrors(t *testing.T) {
+	os.Unsetenv("PRISMACLOUDCOMPUTE_TEST_UNSET")
+
+	dir, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		content, profile, expected string
+	}{
+		{"profiles:\n  prod:\n    url: x\n  dev:\n    url: y\n", "qa", "it has: dev, prod"},
+		{"profiles:\n  default:\n    pasword: x\n", "", `unknown setting "pasword"`},
+		{"profiles:\n  default:\n    port: eighty\n", "", "port: expected an integer"},
+		{"profiles:\n  default:\n    password: ${PRISMACLOUDCOMPUTE_TEST_UNSET}\n", "", "PRISMACLOUDCOMPUTE_TEST_UNSET is not set"},
+		{"profile:\n  default:\n    url: x\n", "", "Error parsing config_file"},
+	}
+
+	for _, tc := range cases {
+		_, err := loadProfile(writeConfigFile(t, dir, "config.yaml", tc.content), tc.profile)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("%q: got error %v, expected it to contain %q", tc.content, err, tc.expected)
+		}
+	}
+}
+
+func TestProviderSettings(t *testing.T) {
+	s := Provider().(*schema.Provider).Schema
+	for _, key := range []string{"url", "port", "skip_ssl_cert_verification"} {
+		s[key].DefaultFunc = nil
+	}
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"url":  "block.example.com",
+		"port": 443,
+	})
+	settings := providerSettings{d: d, profile: map[string]interface{}{
+		"url":                        "profile.example.com",
+		"protocol":                   "http",
+		"port":                       8083,
+		"skip_ssl_cert_verification": true,
+	}}
+
+	if got := settings.String("url"); got != "block.example.com" {
+		t.Errorf("url: got %q, expected the provider block to win", got)
+	}
+	if got := settings.String("protocol"); got != "http" {
+		t.Errorf("protocol: got %q, expected the profile's", got)
+	}
+	if got := settings.Int("port"); got != 443 {
+		t.Errorf("port: got %d, expected the provider block to win", got)
+	}
+	if got, ok := settings.Bool("skip_ssl_cert_verification"); !got || !ok {
+		t.Errorf("skip_ssl_cert_verification: got %t (%t), expected the profile's", got, ok)
+	}
+	if _, ok := settings.Bool("disable_reconnect"); ok {
+		t.Errorf("disable_reconnect: expected it to be unset")
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -33,6 +34,37 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_PASSWORD", nil),
 				Sensitive:   true,
 			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Access key ID, for Prisma Cloud Compute SaaS Consoles",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_ACCESS_KEY", nil),
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Secret key, for Prisma Cloud Compute SaaS Consoles",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_SECRET_KEY", nil),
+				Sensitive:   true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Region of a SaaS Console, such as us-east1, used to build the URL when url is not set",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_REGION", nil),
+			},
+			"tenant": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Tenant path prefix of a SaaS Console, such as us-1-111111111",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_TENANT", nil),
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Project (tenant console) to manage, when connected to a master console",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_PROJECT", nil),
+			},
 			"port": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -55,13 +87,92 @@ func Provider() terraform.ResourceProvider {
 			"timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "The timeout in seconds for all communications with Prisma Cloud",
-				Default:     90,
+				Description: "The timeout in seconds for all communications with Prisma Cloud (default 90)",
 			},
 			"skip_ssl_cert_verification": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "Skip SSL certificate verification",
+			},
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to a PEM file of CA certificates to trust in addition to the system ones",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_CA_CERT_FILE", nil),
+			},
+			"ca_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM-encoded CA certificates to trust in addition to the system ones",
+			},
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM-encoded client certificate, or the path to one, for mutual TLS",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_CLIENT_CERT", nil),
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM-encoded private key of client_cert, or the path to one",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_CLIENT_KEY", nil),
+				Sensitive:   true,
+			},
+			"min_tls_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Minimum TLS version to accept (default 1.2)",
+				ValidateFunc: validation.StringInSlice([]string{"1.0", "1.1", "1.2", "1.3"}, false),
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the proxy to connect through, instead of the one from HTTPS_PROXY / HTTP_PROXY",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_PROXY_URL", nil),
+			},
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma-separated hosts, domains and CIDRs to connect to directly, instead of the ones from NO_PROXY",
+			},
+			"proxy_username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Username to authenticate to the proxy with",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_PROXY_USERNAME", nil),
+			},
+			"proxy_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Password to authenticate to the proxy with",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_PROXY_PASSWORD", nil),
+				Sensitive:   true,
+			},
+			"custom_headers": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:     true,
+				Description:  "HTTP headers to add to every API request",
+				ValidateFunc: validateHeaders,
+			},
+			"max_requests_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of API calls started per second, 0 for no limit",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of API calls in flight at once, 0 for no limit",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"cache_policies": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Share policy reads between resources and data sources during a run",
 				Default:     true,
 			},
 			"logging": {
@@ -84,12 +195,48 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_JSON_WEB_TOKEN", nil),
 				Sensitive:   true,
 			},
+			"credentials_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Command that prints the credentials as JSON, run with the system shell",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_CREDENTIALS_COMMAND", nil),
+			},
+			"token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File holding a JSON web token, re-read whenever it changes",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_TOKEN_FILE", nil),
+			},
 			"json_config_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Retrieve the provider configuration from this JSON file",
 				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_JSON_CONFIG_FILE", nil),
 			},
+			"config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Retrieve the provider configuration from a profile in this JSON or YAML file",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_CONFIG_FILE", nil),
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Profile of config_file to use",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_PROFILE", nil),
+			},
+			"exception_expiration_warning_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Warn during plan about CVE rule and tag exceptions expiring within this many days",
+				Default:      14,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"drop_expired_exceptions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Leave expired CVE rule and tag exceptions out of the policies sent to the Console",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -103,11 +250,33 @@ func Provider() terraform.ResourceProvider {
 			"prismacloudcompute_policiesruntimehost":      resourcePoliciesRuntimeHost(),
 			"prismacloudcompute_policiesvulnerabilityhost":      resourcePoliciesVulnerabilityHost(),
 			"prismacloudcompute_policiescompliancehost":         resourcePoliciesComplianceHost(),
+			"prismacloudcompute_tag":                            resourceTag(),
+			"prismacloudcompute_tag_assignment":                 resourceTagAssignment(),
+			"prismacloudcompute_custom_compliance":              resourceCustomCompliance(),
+			"prismacloudcompute_settings_logon":                 resourceSettingsLogon(),
+			"prismacloudcompute_settings_ldap":                  resourceSettingsLdap(),
+			"prismacloudcompute_settings_saml":                  resourceSettingsSaml(),
+			"prismacloudcompute_settings_oidc":                  resourceSettingsOidc(),
+			"prismacloudcompute_settings_oauth":                 resourceSettingsOauth(),
+			"prismacloudcompute_settings_scan":                  resourceSettingsScan(),
+			"prismacloudcompute_settings_defender":              resourceSettingsDefender(),
+			"prismacloudcompute_settings_runtime":               resourceSettingsRuntime(),
+			"prismacloudcompute_settings_forensic":              resourceSettingsForensic(),
+			"prismacloudcompute_settings_logging":               resourceSettingsLogging(),
+			"prismacloudcompute_cloud_discovery":                resourceCloudDiscovery(),
+			"prismacloudcompute_cloud_scan_settings":            resourceCloudScanSettings(),
+			"prismacloudcompute_secrets_store":                  resourceSecretsStore(),
+			"prismacloudcompute_secrets_policy":                 resourceSecretsPolicy(),
+			"prismacloudcompute_settings_serverless_scan":       resourceSettingsServerlessScan(),
+			"prismacloudcompute_settings_vm_image_scan":         resourceSettingsVmImageScan(),
+			"prismacloudcompute_settings_tas_scan":              resourceSettingsTasScan(),
+			"prismacloudcompute_settings_coderepos":             resourceSettingsCodeRepos(),
+			"prismacloudcompute_service_user":                   resourceServiceUser(),
+			"prismacloudcompute_access_key":                     resourceAccessKey(),
 /*															"prismacloudcompute_users":                            resourceUsers(),
 															"prismacloudcompute_usersid":                         resourceUsersId(),
 															"prismacloudcompute_groups":                           resourceGroups(),
-															"prismacloudcompute_groupsid":                        resourceGroupsId(),
-															"prismacloudcompute_settingslogon":                   resourceSettingsLogon(),*/
+															"prismacloudcompute_groupsid":                        resourceGroupsId(),*/
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -121,11 +290,11 @@ func Provider() terraform.ResourceProvider {
 			"prismacloudcompute_policiesruntimehost":            dataSourcePoliciesRuntimeHost(),
 			"prismacloudcompute_policiesvulnerabilityhost":      dataSourcePoliciesVulnerabilityHost(),
 			"prismacloudcompute_policiescompliancehost":         dataSourcePoliciesComplianceHost(),
+			"prismacloudcompute_compliance_checks":              dataSourceComplianceChecks(),
 /*			"prismacloudcompute_users":                            dataSourceUsers(),
 			"prismacloudcompute_usersid":                         dataSourceUsersId(),
 			"prismacloudcompute_groups":                           dataSourceGroups(),
-			"prismacloudcompute_groupsid":                        dataSourceGroupsId(),
-			"prismacloudcompute_settingslogon":                   dataSourceSettingsLogon(),*/
+			"prismacloudcompute_groupsid":                        dataSourceGroupsId(),*/
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -152,25 +321,152 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, fmt.Errorf("This provider requires a 64bit OS")
 	}
 
+	var prof map[string]interface{}
+	if filename := d.Get("config_file").(string); filename != "" {
+		var err error
+		if prof, err = loadProfile(filename, d.Get("profile").(string)); err != nil {
+			return nil, err
+		}
+	}
+	settings := providerSettings{d: d, profile: prof}
+
+	configFile := d.Get("json_config_file").(string)
+	fileConf, err := readConfigFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+
 	logSetting := make(map[string]bool)
-	logConfig := d.Get("logging").(map[string]interface{})
+	logConfig := settings.Map("logging")
 	for key := range logConfig {
 		logSetting[key] = logConfig[key].(bool)
 	}
+	if len(logSetting) == 0 {
+		for key, val := range fileConf.Logging {
+			logSetting[key] = val
+		}
+	}
+	if len(logSetting) == 0 {
+		logSetting[pc.LogAction] = true
+	}
+
+	/*
+	   `send` and `receive` are handled by the provider's API call logging,
+	   which redacts the bodies, rather than by the SDK's.
+	*/
+	logCalls := !logSetting[pc.LogQuiet]
+	logBodies := logCalls && (logSetting[pc.LogSend] || logSetting[pc.LogReceive] || logging.LogLevel() == "TRACE")
+	logSetting[pc.LogSend] = false
+	logSetting[pc.LogReceive] = false
+
+	username := settings.String("username")
+	password := settings.String("password")
+	accessKey := settings.String("access_key")
+	secretKey := settings.String("secret_key")
+	token := settings.String("json_web_token")
+
+	// Credentials from the command fill in whatever is not configured.
+	if command := settings.String("credentials_command"); command != "" {
+		creds, err := runCredentialsCommand(command)
+		if err != nil {
+			return nil, err
+		}
+		if username == "" && accessKey == "" {
+			username, password = creds.Username, creds.Password
+			accessKey, secretKey = creds.AccessKey, creds.SecretKey
+		}
+		if token == "" {
+			token = creds.Token
+		}
+	}
+
+	var tokens *tokenFile
+	if path := settings.String("token_file"); path != "" {
+		tokens = &tokenFile{path: path}
+		fileToken, _, err := tokens.read()
+		if err != nil {
+			return nil, err
+		}
+		token = fileToken
+	}
+
+	if accessKey != "" {
+		if username != "" {
+			return nil, fmt.Errorf("Specify either access_key / secret_key or username / password, not both")
+		}
+		username, password = accessKey, secretKey
+	}
+
+	url, err := consoleUrl(settings.String("url"), settings.String("region"), settings.String("tenant"))
+	if err != nil {
+		return nil, err
+	}
+
+	/*
+	   The SDK replaces skip_ssl_cert_verification with the config file's
+	   value, so resolve it here: the provider block wins over the file.
+	*/
+	skipVerify, explicit := settings.Bool("skip_ssl_cert_verification")
+	if !explicit {
+		skipVerify = fileConf.SkipSslCertVerification
+	}
+	disableReconnect, _ := settings.Bool("disable_reconnect")
+
+	headers := make(map[string]string)
+	for key, val := range settings.Map("custom_headers") {
+		headers[key] = val.(string)
+	}
+
+	var cache *responseCache
+	if d.Get("cache_policies").(bool) {
+		cache = newResponseCache()
+	}
+
+	transport, err := newTransport(transportConfig{
+		skipVerify:    skipVerify,
+		caCertFile:    settings.String("ca_cert_file"),
+		caCertPem:     settings.String("ca_cert_pem"),
+		clientCert:    settings.String("client_cert"),
+		clientKey:     settings.String("client_key"),
+		minTlsVersion: settings.String("min_tls_version"),
+		proxyUrl:      settings.String("proxy_url"),
+		noProxy:       settings.String("no_proxy"),
+		proxyUsername: settings.String("proxy_username"),
+		proxyPassword: settings.String("proxy_password"),
+		headers:       headers,
+		logCalls:      logCalls,
+		logBodies:     logBodies,
+		perSecond:     settings.Int("max_requests_per_second"),
+		concurrent:    settings.Int("max_concurrent_requests"),
+		cache:         cache,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	con := &pc.Client{
-		Url:                     d.Get("url").(string),
-		Username:                d.Get("username").(string),
-		Password:                d.Get("password").(string),
-		Port:                    d.Get("port").(int),
-		Protocol:                d.Get("protocol").(string),
-		Timeout:                 d.Get("timeout").(int),
-		SkipSslCertVerification: d.Get("skip_ssl_cert_verification").(bool),
-		DisableReconnect:        d.Get("disable_reconnect").(bool),
-		JsonWebToken:            d.Get("json_web_token").(string),
+		Url:                     url,
+		Username:                username,
+		Password:                password,
+		Port:                    settings.Int("port"),
+		Protocol:                settings.String("protocol"),
+		Timeout:                 settings.Int("timeout"),
+		SkipSslCertVerification: skipVerify,
+		DisableReconnect:        disableReconnect,
+		JsonWebToken:            token,
 		Logging:                 logSetting,
+		Transport:               transport,
+	}
+
+	client := newApiClient(con)
+	client.project = settings.String("project")
+	client.tokenFile = tokens
+	client.exceptionWarningDays = d.Get("exception_expiration_warning_days").(int)
+	client.dropExpiredExceptions = d.Get("drop_expired_exceptions").(bool)
+
+	if err := client.Initialize(configFile); err != nil {
+		return nil, err
 	}
 
-	err := con.Initialize(d.Get("json_config_file").(string))
-	return con, err
+	return client, nil
 }
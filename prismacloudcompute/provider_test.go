@@ -30,7 +30,7 @@ func init() {
 		"prismacloudcompute": testAccProvider,
 	}
 
-	client := &pc.Client{}
+	client := newApiClient(&pc.Client{})
 	if err = client.Initialize(os.Getenv(PrismacloudcomputeJsonConfigFileEnvVar)); err == nil {
 		if err != nil {
 			fmt.Sprintf("Error initializing client")
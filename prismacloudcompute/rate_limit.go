@@ -0,0 +1,177 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Requests rejected with 429 Too Many Requests are retried this many times.
+const maxRateLimitRetries = 5
+
+// Longest wait before retrying a rate limited request, whatever the Console
+// asks for in Retry-After.
+const maxRetryAfter = time.Minute
+
+/*
+rateLimitTransport enforces `max_requests_per_second` and
+`max_concurrent_requests` across every resource sharing the provider, and
+retries requests the Console rejects with 429 Too Many Requests, waiting as
+long as Retry-After says.
+*/
+type rateLimitTransport struct {
+	next http.RoundTripper
+
+	// Minimum time between the start of two requests, zero for no limit.
+	interval time.Duration
+	mu       sync.Mutex
+	nextSlot time.Time
+
+	// Requests in flight, nil for no limit.
+	slots chan struct{}
+}
+
+func newRateLimitTransport(next http.RoundTripper, perSecond, concurrent int) *rateLimitTransport {
+	t := &rateLimitTransport{next: next}
+	if perSecond > 0 {
+		t.interval = time.Second / time.Duration(perSecond)
+	}
+	if concurrent > 0 {
+		t.slots = make(chan struct{}, concurrent)
+	}
+
+	return t
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := t.acquire(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(req)
+		if err != nil {
+			t.release()
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusTooManyRequests || attempt == maxRateLimitRetries {
+			resp.Body = &releasingBody{ReadCloser: resp.Body, release: t.release}
+			return resp, nil
+		}
+
+		wait := retryAfter(resp, attempt)
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		t.release()
+
+		logDebug("api call rate limited", "method", req.Method, "path", redactedPath(req.URL), "retry_in", wait)
+
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return nil, fmt.Errorf("Rate limited on %s %s and the request cannot be retried", req.Method, req.URL.Path)
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// acquire waits for a free request slot and then for the request's turn.
+func (t *rateLimitTransport) acquire(req *http.Request) error {
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-req.Context().Done():
+			return req.Context().Err()
+		}
+	}
+
+	if t.interval == 0 {
+		return nil
+	}
+
+	t.mu.Lock()
+	now := time.Now()
+	if t.nextSlot.Before(now) {
+		t.nextSlot = now
+	}
+	wait := t.nextSlot.Sub(now)
+	t.nextSlot = t.nextSlot.Add(t.interval)
+	t.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		t.release()
+		return req.Context().Err()
+	}
+}
+
+func (t *rateLimitTransport) release() {
+	if t.slots != nil {
+		<-t.slots
+	}
+}
+
+/*
+retryAfter returns how long to wait before retrying a rate limited request:
+the Retry-After header, in seconds or as a date, or else an exponential
+backoff starting at one second.
+*/
+func retryAfter(resp *http.Response, attempt int) time.Duration {
+	wait := time.Second << uint(attempt)
+
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			wait = time.Duration(secs) * time.Second
+		} else if date, err := http.ParseTime(v); err == nil {
+			wait = time.Until(date)
+		}
+	}
+
+	if wait < 0 {
+		wait = 0
+	}
+	if wait > maxRetryAfter {
+		wait = maxRetryAfter
+	}
+
+	return wait
+}
+
+// releasingBody frees the request slot once the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,125 @@
+package prismacloudcompute
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimitRetry(t *testing.T) {
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 0, 1)}
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader(`{"name":"rule"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d, expected 200", resp.StatusCode)
+	}
+	if len(bodies) != 3 {
+		t.Fatalf("got %d attempts, expected 3", len(bodies))
+	}
+	for i, b := range bodies {
+		if b != `{"name":"rule"}` {
+			t.Errorf("attempt %d sent body %q", i, b)
+		}
+	}
+}
+
+func TestRateLimitConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 0, 2)}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if resp, err := client.Get(srv.URL); err == nil {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight != 2 {
+		t.Errorf("got at most %d requests in flight, expected 2", maxInFlight)
+	}
+}
+
+func TestRateLimitPerSecond(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 50, 0)}
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	// The first request goes right away, the other five wait 20ms each.
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("6 requests took %s, expected at least 100ms", elapsed)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	cases := []struct {
+		header   string
+		attempt  int
+		expected time.Duration
+	}{
+		{"", 0, time.Second},
+		{"", 2, 4 * time.Second},
+		{"7", 0, 7 * time.Second},
+		{"3600", 0, maxRetryAfter},
+		{"Mon, 02 Jan 2006 15:04:05 GMT", 0, 0},
+		{"soon", 1, 2 * time.Second},
+	}
+
+	for _, tc := range cases {
+		resp := &http.Response{Header: http.Header{}}
+		if tc.header != "" {
+			resp.Header.Set("Retry-After", tc.header)
+		}
+		if got := retryAfter(resp, tc.attempt); got != tc.expected {
+			t.Errorf("%q attempt %d: got %s, expected %s", tc.header, tc.attempt, got, tc.expected)
+		}
+	}
+}
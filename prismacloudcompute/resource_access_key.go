@@ -0,0 +1,143 @@
+package prismacloudcompute
+
+import (
+	"strconv"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/user"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Access keys are minted by setting a fresh random password on a service
+// user: the username is the access key ID and the password its secret.  The
+// Console never returns passwords, so the secret is only known at creation
+// and is kept in the state from then on.  Every argument forces a new key,
+// which is how keys are rotated.
+//
+// A user has a single password, so a newer key for the same user replaces an
+// older one.  Deleting a key first checks that its secret still logs in, so
+// deleting a replaced key leaves the newer one alone.  Reads do not log in,
+// which would be an audited (and eventually locked out) login on every plan.
+func resourceAccessKey() *schema.Resource {
+	return &schema.Resource{
+		Create: createAccessKey,
+		Read:   readAccessKey,
+		Delete: deleteAccessKey,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"user": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Username of the service user the key is for.",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that rotate the key whenever they change.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the access key.",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Secret of the access key.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date/time when the key was created.",
+			},
+		},
+	}
+}
+
+func createAccessKey(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	username := d.Get("user").(string)
+
+	secret, err := newUserSecret()
+	if err != nil {
+		return err
+	}
+
+	if err := user.SetPassword(client, username, secret); err != nil {
+		return err
+	}
+
+	d.SetId(TwoStringsToId(username, strconv.FormatInt(time.Now().Unix(), 10)))
+	d.Set("secret", secret)
+	return readAccessKey(d, meta)
+}
+
+func readAccessKey(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	username, created := IdToTwoStrings(d.Id())
+
+	obj, err := user.Get(client, username)
+	if err != nil {
+		if err == pc.ObjectNotFoundError {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("user", obj.Username)
+	d.Set("access_key_id", obj.Username)
+	if sec, err := strconv.ParseInt(created, 10, 64); err == nil {
+		d.Set("created_at", time.Unix(sec, 0).UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func deleteAccessKey(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	username, _ := IdToTwoStrings(d.Id())
+
+	// A newer key, such as the replacement of this one under
+	// create_before_destroy, already revoked this secret and must be kept.
+	if old := d.Get("secret").(string); old != "" {
+		valid, err := client.checkCredentials(username, old)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			logDebug("access key already replaced, not revoking", "user", username)
+			d.SetId("")
+			return nil
+		}
+	}
+
+	// Revoke the secret by replacing it with one nobody knows.
+	secret, err := newUserSecret()
+	if err != nil {
+		return err
+	}
+
+	if err := user.SetPassword(client, username, secret); err != nil {
+		if err != pc.ObjectNotFoundError {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,126 @@
+package prismacloudcompute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/user"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestAccessKeyRotation(t *testing.T) {
+	users := map[string]user.User{}
+	var loginsOf []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/authenticate":
+			var login map[string]string
+			json.NewDecoder(r.Body).Decode(&login)
+			loginsOf = append(loginsOf, login["username"])
+			if u, ok := users[login["username"]]; login["username"] != "admin" && (!ok || u.Password != login["password"]) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"token": "opaque"}`)
+		case r.URL.Path == "/api/v1/users" && r.Method == "GET":
+			list := []user.User{}
+			for _, u := range users {
+				u.Password = ""
+				list = append(list, u)
+			}
+			json.NewEncoder(w).Encode(list)
+		case r.URL.Path == "/api/v1/users" && (r.Method == "POST" || r.Method == "PUT"):
+			var u user.User
+			json.NewDecoder(r.Body).Decode(&u)
+			if old, ok := users[u.Username]; ok && u.Password == "" {
+				u.Password = old.Password
+			}
+			users[u.Username] = u
+		case strings.HasPrefix(r.URL.Path, "/api/v1/users/") && r.Method == "DELETE":
+			delete(users, strings.TrimPrefix(r.URL.Path, "/api/v1/users/"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := newApiClient(&pc.Client{
+		Url:      strings.TrimPrefix(srv.URL, "http://"),
+		Protocol: "http",
+		Username: "admin",
+		Password: "admin",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+
+	su := schema.TestResourceDataRaw(t, resourceServiceUser().Schema, map[string]interface{}{
+		"username":    "pipeline",
+		"collections": []interface{}{"payments"},
+	})
+	if err := createServiceUser(su, client); err != nil {
+		t.Fatal(err)
+	}
+	if u := users["pipeline"]; u.Role != user.RoleCi || len(u.Permissions) != 1 || u.Permissions[0].Collections[0] != "payments" {
+		t.Errorf("service user is %#v", u)
+	}
+
+	keys := make([]*schema.ResourceData, 2)
+	for i := range keys {
+		keys[i] = schema.TestResourceDataRaw(t, resourceAccessKey().Schema, map[string]interface{}{
+			"user":             "pipeline",
+			"rotation_trigger": map[string]interface{}{"generation": fmt.Sprint(i)},
+		})
+		if err := createAccessKey(keys[i], client); err != nil {
+			t.Fatal(err)
+		}
+		secret := keys[i].Get("secret").(string)
+		if secret == "" || users["pipeline"].Password != secret {
+			t.Errorf("key %d: secret %q is not the password of the user", i, secret)
+		}
+		if keys[i].Get("access_key_id").(string) != "pipeline" || keys[i].Get("created_at").(string) == "" {
+			t.Errorf("key %d: access key ID %q created at %q", i, keys[i].Get("access_key_id"), keys[i].Get("created_at"))
+		}
+	}
+	if keys[0].Get("secret") == keys[1].Get("secret") {
+		t.Error("rotation did not change the secret")
+	}
+
+	// The first key was replaced by the second, like under
+	// create_before_destroy, so deleting it must not revoke the second.
+	if err := deleteAccessKey(keys[0], client); err != nil {
+		t.Fatal(err)
+	}
+	if users["pipeline"].Password != keys[1].Get("secret").(string) {
+		t.Error("deleting the replaced key revoked the newer one")
+	}
+	logins := len(loginsOf)
+	if err := readAccessKey(keys[1], client); err != nil || keys[1].Id() == "" {
+		t.Errorf("current key was removed: %v", err)
+	}
+	if len(loginsOf) != logins {
+		t.Errorf("reading the key logged in as %v", loginsOf[logins:])
+	}
+
+	if err := deleteAccessKey(keys[1], client); err != nil {
+		t.Fatal(err)
+	}
+	if users["pipeline"].Password == keys[1].Get("secret").(string) {
+		t.Error("deleting the key did not revoke its secret")
+	}
+
+	if err := deleteServiceUser(su, client); err != nil {
+		t.Fatal(err)
+	}
+	keys[0].SetId(TwoStringsToId("pipeline", "0"))
+	if err := readAccessKey(keys[0], client); err != nil || keys[0].Id() != "" {
+		t.Errorf("key of a deleted user was not removed: %q, %v", keys[0].Id(), err)
+	}
+}
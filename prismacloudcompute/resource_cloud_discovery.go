@@ -0,0 +1,90 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceCloudDiscovery() *schema.Resource {
+	return &schema.Resource{
+		Create: updateCloudDiscovery,
+		Read:   readCloudDiscovery,
+		Update: updateCloudDiscovery,
+		Delete: deleteCloudDiscovery,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"account": cloudAccountsSchema("Cloud accounts whose resources are discovered."),
+			"discovery_period_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      settings.DefaultCloudDiscovery.DiscoveryPeriodMs / msPerHour,
+				Description:  "Hours between discoveries of the cloud accounts.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func parseCloudDiscovery(d *schema.ResourceData) settings.CloudDiscovery {
+	return settings.CloudDiscovery{
+		Accounts:          parseCloudAccounts(d.Get("account").([]interface{})),
+		DiscoveryPeriodMs: d.Get("discovery_period_hours").(int) * msPerHour,
+	}
+}
+
+func saveCloudDiscovery(d *schema.ResourceData, obj settings.CloudDiscovery) {
+	d.Set("account", flattenCloudAccounts(obj.Accounts))
+	d.Set("discovery_period_hours", scanPeriodHours(obj.DiscoveryPeriodMs))
+}
+
+func readCloudDiscovery(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetCloudDiscovery(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.CloudDiscoveryName)
+	saveCloudDiscovery(d, obj)
+
+	return nil
+}
+
+func updateCloudDiscovery(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseCloudDiscovery(d)
+
+	if err := settings.UpdateCloudDiscovery(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.CloudDiscoveryName)
+	return readCloudDiscovery(d, meta)
+}
+
+func deleteCloudDiscovery(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateCloudDiscovery(client, settings.DefaultCloudDiscovery); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,66 @@
+package prismacloudcompute
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestParseCloudDiscovery(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudDiscovery().Schema, map[string]interface{}{
+		"account": []interface{}{
+			map[string]interface{}{
+				"credential_id": "aws-prod",
+				"provider":      "aws",
+				"regions":       []interface{}{"us-east-1", "eu-west-1"},
+				"services":      []interface{}{"awsEc2"},
+			},
+			map[string]interface{}{
+				"credential_id": "gcp-prod",
+				"provider":      "gcp",
+			},
+		},
+		"discovery_period_hours": 6,
+	})
+
+	obj := parseCloudDiscovery(d)
+	if obj.DiscoveryPeriodMs != 6*msPerHour {
+		t.Errorf("discovery period is %dms, expected 6 hours", obj.DiscoveryPeriodMs)
+	}
+	if len(obj.Accounts) != 2 {
+		t.Fatalf("got %d accounts, expected 2", len(obj.Accounts))
+	}
+
+	aws := obj.Accounts[0]
+	sort.Strings(aws.Regions)
+	expected := settings.CloudAccount{
+		CredentialId: "aws-prod",
+		Provider:     settings.CloudProviderAws,
+		Regions:      []string{"eu-west-1", "us-east-1"},
+		Services:     []string{"awsEc2"},
+	}
+	if !reflect.DeepEqual(aws, expected) {
+		t.Errorf("account is %#v, expected %#v", aws, expected)
+	}
+
+	gcp := obj.Accounts[1]
+	if len(gcp.Regions) != 0 || len(gcp.Services) != 0 {
+		t.Errorf("account without regions or services is %#v", gcp)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceCloudDiscovery().Schema, map[string]interface{}{})
+	saveCloudDiscovery(d, obj)
+	if again := parseCloudDiscovery(d); len(again.Accounts) != 2 || again.Accounts[1].CredentialId != "gcp-prod" {
+		t.Errorf("accounts did not survive a round trip: %#v", again.Accounts)
+	}
+
+	obj.DiscoveryPeriodMs = 20 * 60 * 1000
+	saveCloudDiscovery(d, obj)
+	if hours := d.Get("discovery_period_hours").(int); hours != 1 {
+		t.Errorf("discovery period of 20 minutes read as %d hours, expected 1", hours)
+	}
+}
@@ -0,0 +1,80 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceCloudScanSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: updateCloudScanSettings,
+		Read:   readCloudScanSettings,
+		Update: updateCloudScanSettings,
+		Delete: deleteCloudScanSettings,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"account": cloudAccountsSchema("Cloud accounts whose resources are scanned for compliance."),
+		},
+	}
+}
+
+func parseCloudScanSettings(d *schema.ResourceData) settings.CloudScan {
+	return settings.CloudScan{
+		Accounts: parseCloudAccounts(d.Get("account").([]interface{})),
+	}
+}
+
+func saveCloudScanSettings(d *schema.ResourceData, obj settings.CloudScan) {
+	d.Set("account", flattenCloudAccounts(obj.Accounts))
+}
+
+func readCloudScanSettings(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetCloudScan(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.CloudScanName)
+	saveCloudScanSettings(d, obj)
+
+	return nil
+}
+
+func updateCloudScanSettings(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseCloudScanSettings(d)
+
+	if err := settings.UpdateCloudScan(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.CloudScanName)
+	return readCloudScanSettings(d, meta)
+}
+
+func deleteCloudScanSettings(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateCloudScan(client, settings.DefaultCloudScan); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -1,7 +1,6 @@
 package prismacloudcompute
 
 import (
-	"log"
 	"time"
 
 	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
@@ -28,6 +27,7 @@ func resourceCollection() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
 			"accountids": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -225,38 +225,38 @@ func parseCollection(d *schema.ResourceData, id string) collection.Collection {
 
 func saveCollection(d *schema.ResourceData, obj collection.Collection) {
 	if err := d.Set("accountids", StringSliceToSet(obj.AccountIDs)); err != nil {
-		log.Printf("[WARN] Error setting 'accountIDs' for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "accountIDs", "id", d.Id(), "error", err)
 	}
 	if err := d.Set("appids", StringSliceToSet(obj.AppIDs)); err != nil {
-		log.Printf("[WARN] Error setting 'appIDs' for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "appIDs", "id", d.Id(), "error", err)
 	}
 	if err := d.Set("clusters", StringSliceToSet(obj.Clusters)); err != nil {
-		log.Printf("[WARN] Error setting 'clusters' for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "clusters", "id", d.Id(), "error", err)
 	}
 	if err := d.Set("coderepos", StringSliceToSet(obj.CodeRepos)); err != nil {
-		log.Printf("[WARN] Error setting 'codeRepos' for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "codeRepos", "id", d.Id(), "error", err)
 	}
 	d.Set("color", obj.Color)
 	if err := d.Set("containers", StringSliceToSet(obj.Containers)); err != nil {
-		log.Printf("[WARN] Error setting 'containers' for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "containers", "id", d.Id(), "error", err)
 	}
 	d.Set("description", obj.Description)
 	if err := d.Set("functions", StringSliceToSet(obj.Functions)); err != nil {
-		log.Printf("[WARN] Error setting 'functions' for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "functions", "id", d.Id(), "error", err)
 	}
 	if err := d.Set("hosts", StringSliceToSet(obj.Hosts)); err != nil {
-		log.Printf("[WARN] Error setting 'hosts' for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "hosts", "id", d.Id(), "error", err)
 	}
 	if err := d.Set("images", StringSliceToSet(obj.Images)); err != nil {
-		log.Printf("[WARN] Error setting 'images' for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "images", "id", d.Id(), "error", err)
 	}
 	if err := d.Set("labels", StringSliceToSet(obj.Labels)); err != nil {
-		log.Printf("[WARN] Error setting 'labels' for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "labels", "id", d.Id(), "error", err)
 	}
 	// d.Set("modified", obj.Modified)
 	d.Set("name", obj.Name)
 	if err := d.Set("namespaces", StringSliceToSet(obj.Namespaces)); err != nil {
-		log.Printf("[WARN] Error setting 'namespaces' for %q: %s", d.Id(), err)
+		logWarn("error setting field", "field", "namespaces", "id", d.Id(), "error", err)
 	}
 	d.Set("owner", obj.Owner)
 	// d.Set("prisma", obj.Prisma)
@@ -264,17 +264,17 @@ func saveCollection(d *schema.ResourceData, obj collection.Collection) {
 }
 
 func createCollection(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	obj := parseCollection(d, "")
 
 	if err := collection.Create(client, obj); err != nil {
-		log.Printf("Failed to create collection: %s\n", err)
+		logWarn("error creating collection", "error", err)
 		return err
 	}
 
 	PollApiUntilSuccess(func() error {
 		_, err := collection.Get(client, obj.Name)
-		log.Printf("Failed to get collection %s: %s\n", obj.Name, err)
+		logWarn("error reading collection", "name", obj.Name, "error", err)
 		return err
 	})
 
@@ -283,7 +283,7 @@ func createCollection(d *schema.ResourceData, meta interface{}) error {
 }
 
 func readCollection(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	obj := parseCollection(d, "")
 	id := d.Id()
 
@@ -302,7 +302,7 @@ func readCollection(d *schema.ResourceData, meta interface{}) error {
 }
 
 func updateCollection(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	id := d.Id()
 	obj := parseCollection(d, id)
 
@@ -314,7 +314,7 @@ func updateCollection(d *schema.ResourceData, meta interface{}) error {
 }
 
 func deleteCollection(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	id := d.Id()
 
 	err := collection.Delete(client, id)
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"testing"
 
-	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/collection"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -108,7 +107,7 @@ func testAccCheckCollectionExists(n string, o *collection.Collection) resource.T
 			return fmt.Errorf("Object label Name is not set")
 		}
 
-		client := testAccProvider.Meta().(*pc.Client)
+		client := testAccProvider.Meta().(*apiClient)
 		name := rs.Primary.ID
 		lo, err := collection.Get(client, name)
 		if err != nil {
@@ -141,7 +140,7 @@ func testAccCheckCollectionAttributes(o *collection.Collection, name string, des
 }
 
 func testAccCollectionDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*pc.Client)
+	client := testAccProvider.Meta().(*apiClient)
 
 	for _, rs := range s.RootModule().Resources {
 
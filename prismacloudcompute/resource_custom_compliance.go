@@ -0,0 +1,169 @@
+package prismacloudcompute
+
+import (
+	"strconv"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/customCompliance"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceCustomCompliance() *schema.Resource {
+	return &schema.Resource{
+		Create: createCustomCompliance,
+		Read:   readCustomCompliance,
+		Update: updateCustomCompliance,
+		Delete: deleteCustomCompliance,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name of the check.",
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Title shown for the check in compliance reports.",
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     customCompliance.SeverityMedium,
+				Description: "Severity of the check. Can be set to 'critical', 'high', 'medium', or 'low'.",
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						customCompliance.SeverityCritical,
+						customCompliance.SeverityHigh,
+						customCompliance.SeverityMedium,
+						customCompliance.SeverityLow,
+					},
+					false,
+				),
+			},
+			"script": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Shell script run by Defender. A non-zero exit code fails the check.",
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User who created or last modified the check.",
+			},
+			"modified": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date/time when the check was last modified.",
+			},
+		},
+	}
+}
+
+func parseCustomCompliance(d *schema.ResourceData, id int) customCompliance.CustomCompliance {
+	return customCompliance.CustomCompliance{
+		Id:       id,
+		Name:     d.Get("name").(string),
+		Title:    d.Get("title").(string),
+		Severity: d.Get("severity").(string),
+		Script:   d.Get("script").(string),
+	}
+}
+
+func saveCustomCompliance(d *schema.ResourceData, obj customCompliance.CustomCompliance) {
+	d.Set("name", obj.Name)
+	d.Set("title", obj.Title)
+	d.Set("severity", obj.Severity)
+	d.Set("script", obj.Script)
+	d.Set("owner", obj.Owner)
+	d.Set("modified", obj.Modified)
+}
+
+func createCustomCompliance(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseCustomCompliance(d, 0)
+
+	if err := customCompliance.Create(client, obj); err != nil {
+		return err
+	}
+
+	var id int
+	PollApiUntilSuccess(func() error {
+		var err error
+		id, err = customCompliance.Identify(client, obj.Name)
+		return err
+	})
+
+	d.SetId(strconv.Itoa(id))
+	return readCustomCompliance(d, meta)
+}
+
+func readCustomCompliance(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	obj, err := customCompliance.Get(client, id)
+	if err != nil {
+		if err == pc.ObjectNotFoundError {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	saveCustomCompliance(d, obj)
+
+	return nil
+}
+
+func updateCustomCompliance(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	obj := parseCustomCompliance(d, id)
+
+	if err := customCompliance.Update(client, obj); err != nil {
+		return err
+	}
+
+	return readCustomCompliance(d, meta)
+}
+
+func deleteCustomCompliance(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := customCompliance.Delete(client, id); err != nil {
+		if err != pc.ObjectNotFoundError {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
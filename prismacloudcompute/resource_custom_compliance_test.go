@@ -0,0 +1,111 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/customCompliance"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccCustomComplianceConfig(t *testing.T) {
+	var o customCompliance.CustomCompliance
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCustomComplianceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomComplianceConfig(name, "high"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomComplianceExists("prismacloudcompute_custom_compliance.test", &o),
+					testAccCheckCustomComplianceAttributes(&o, name, "high"),
+				),
+			},
+			{
+				Config: testAccCustomComplianceConfig(name, "low"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomComplianceExists("prismacloudcompute_custom_compliance.test", &o),
+					testAccCheckCustomComplianceAttributes(&o, name, "low"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomComplianceExists(n string, o *customCompliance.CustomCompliance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Invalid ID %q: %s", rs.Primary.ID, err)
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := customCompliance.Get(client, id)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckCustomComplianceAttributes(o *customCompliance.CustomCompliance, name, severity string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.Name != name {
+			return fmt.Errorf("Name is %s, expected %s", o.Name, name)
+		}
+
+		if o.Severity != severity {
+			return fmt.Errorf("Severity is %s, expected %s", o.Severity, severity)
+		}
+
+		return nil
+	}
+}
+
+func testAccCustomComplianceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_custom_compliance" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			continue
+		}
+		if _, err := customCompliance.Get(client, id); err == nil {
+			return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCustomComplianceConfig(name, severity string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_custom_compliance" "test" {
+    name = %q
+    title = "No world writable files in /etc"
+    severity = %q
+    script = <<-EOT
+        if [ -n "$(find /etc -xdev -type f -perm -0002)" ]; then
+            exit 1
+        fi
+    EOT
+}`, name, severity)
+}
@@ -59,6 +59,10 @@ func parseRules(rules []interface{}) []policy.Rule {
 						}
 					}
 				}
+				rule.Condition = condition
+			}
+			if item["cverules"] != nil {
+				rule.CveRules = parseCveRules(item["cverules"].([]interface{}))
 			}
 			if item["customrules"] != nil {
 				custRules := item["customrules"].([]interface{})
@@ -242,6 +246,9 @@ func parseRules(rules []interface{}) []policy.Rule {
 					rule.Processes.Whitelist = processItem["whitelist"].([]string)
 				}
 			}
+			if item["tags"] != nil {
+				rule.Tags = parseTags(item["tags"].([]interface{}))
+			}
 			if item["wildfireanalysis"] != nil {
 				rule.WildFireAnalysis = item["wildfireanalysis"].(string)
 			}
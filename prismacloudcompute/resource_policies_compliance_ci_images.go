@@ -1,12 +1,13 @@
 package prismacloudcompute
 
 import (
-	"log"
 	"time"
 
 	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyComplianceCiImages"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -16,6 +17,11 @@ func resourcePoliciesComplianceCiImages() *schema.Resource {
 		Read:   readPolicyComplianceCiImages,
 		Update: updatePolicyComplianceCiImages,
 		Delete: deletePolicyComplianceCiImages,
+		CustomizeDiff: customdiff.All(
+			customizeExceptionExpiration("rule"),
+			customizeComplianceTemplates("rule"),
+			customizeRulesUnchanged("compliance CI images", policyComplianceCiImagesRules),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -28,6 +34,10 @@ func resourcePoliciesComplianceCiImages() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"project":         projectSchema(),
+			"force_overwrite": forceOverwriteSchema(),
+			"rules_modified":  rulesModifiedSchema(),
+			"template_checks": templateChecksSchema(),
 			"_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -303,9 +313,10 @@ func resourcePoliciesComplianceCiImages() *schema.Resource {
 										Description: "CVE ID",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The compliance container expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The compliance container expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -491,9 +502,10 @@ func resourcePoliciesComplianceCiImages() *schema.Resource {
 										Description: "Specifies the relevant action for a compliance container. Can be set to 'ignore', 'alert', or 'block'.",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The compliance container expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The compliance container expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -517,6 +529,7 @@ func resourcePoliciesComplianceCiImages() *schema.Resource {
 								},
 							},
 						},
+						"template": complianceTemplateSchema(),
 						"verbose": {
 							Type:        schema.TypeBool,
 							Optional:    true,
@@ -547,6 +560,7 @@ func savePolicyComplianceCiImages(d *schema.ResourceData, obj policyComplianceCi
 	d.Set("_id", obj.PolicyId)
 	d.Set("policytype", obj.PolicyType)
 	d.Set("rule", obj.Rules)
+	initRulesModified(d, obj.Rules)
 
 	// Rule.
 	if len(obj.Rules) > 0 {
@@ -570,15 +584,17 @@ func savePolicyComplianceCiImages(d *schema.ResourceData, obj policyComplianceCi
 		}
 
 		if err := d.Set("rule", []interface{}{rv}); err != nil {
-			log.Printf("[WARN] Error setting 'rule' for %q: %s", d.Id(), err)
+			logWarn("error setting field", "field", "rule", "id", d.Id(), "error", err)
 		}
 	}
 
 }
 
 func createPolicyComplianceCiImages(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	obj := parsePolicyComplianceCiImages(d, "")
+	obj.Rules = client.activeExceptions(obj.Rules)
+	obj.Rules = expandComplianceTemplates(d, obj.Rules)
 
 	if err := policyComplianceCiImages.Create(client, obj); err != nil {
 		return err
@@ -595,11 +611,15 @@ func createPolicyComplianceCiImages(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.SetId(pol.PolicyId)
-	return readPolicyComplianceCiImages(d, meta)
+	if err := readPolicyComplianceCiImages(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyComplianceCiImagesRules)
 }
 
 func readPolicyComplianceCiImages(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	obj, err := policyComplianceCiImages.Get(client)
 	if err != nil {
@@ -615,20 +635,35 @@ func readPolicyComplianceCiImages(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func policyComplianceCiImagesRules(c *apiClient) ([]policy.Rule, error) {
+	pol, err := policyComplianceCiImages.Get(c)
+	return pol.Rules, err
+}
+
 func updatePolicyComplianceCiImages(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	id := d.Id()
 	obj := parsePolicyComplianceCiImages(d, id)
+	obj.Rules = client.activeExceptions(obj.Rules)
+	obj.Rules = expandComplianceTemplates(d, obj.Rules)
+
+	if err := client.checkRulesUnchanged(d, "compliance CI images", policyComplianceCiImagesRules); err != nil {
+		return err
+	}
 
 	if err := policyComplianceCiImages.Update(client, obj); err != nil {
 		return err
 	}
 
-	return readPolicyComplianceCiImages(d, meta)
+	if err := readPolicyComplianceCiImages(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyComplianceCiImagesRules)
 }
 
 func deletePolicyComplianceCiImages(d *schema.ResourceData, meta interface{}) error {
-	/*	client := meta.(*pc.Client)
+	/*	client := meta.(*apiClient)
 		id := d.Id()
 
 		err := policy.Delete(client, id)
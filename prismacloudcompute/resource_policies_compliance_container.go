@@ -1,7 +1,6 @@
 package prismacloudcompute
 
 import (
-	"log"
 	"time"
 
 	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
@@ -9,6 +8,7 @@ import (
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyComplianceContainer"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -18,6 +18,11 @@ func resourcePoliciesComplianceContainer() *schema.Resource {
 		Read:   readPolicyComplianceContainer,
 		Update: updatePolicyComplianceContainer,
 		Delete: deletePolicyComplianceContainer,
+		CustomizeDiff: customdiff.All(
+			customizeExceptionExpiration("rule"),
+			customizeComplianceTemplates("rule"),
+			customizeRulesUnchanged("compliance container", policyComplianceContainerRules),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -30,6 +35,10 @@ func resourcePoliciesComplianceContainer() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"project":         projectSchema(),
+			"force_overwrite": forceOverwriteSchema(),
+			"rules_modified":  rulesModifiedSchema(),
+			"template_checks": templateChecksSchema(),
 			"_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -295,9 +304,10 @@ func resourcePoliciesComplianceContainer() *schema.Resource {
 										Description: "CVE ID",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The compliance container expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The compliance container expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -483,9 +493,10 @@ func resourcePoliciesComplianceContainer() *schema.Resource {
 										Description: "Specifies the relevant action for a compliance container. Can be set to 'ignore', 'alert', or 'block'.",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The compliance container expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The compliance container expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -509,6 +520,7 @@ func resourcePoliciesComplianceContainer() *schema.Resource {
 								},
 							},
 						},
+						"template": complianceTemplateSchema(),
 						"verbose": {
 							Type:        schema.TypeBool,
 							Optional:    true,
@@ -577,6 +589,9 @@ func parsePolicyComplianceContainer(d *schema.ResourceData, id string) policyCom
 				}
 				rule.Condition = condition
 			}
+			if item["cverules"] != nil {
+				rule.CveRules = parseCveRules(item["cverules"].([]interface{}))
+			}
 			if item["customrules"] != nil {
 				custRules := item["customrules"].([]interface{})
 				rule.CustomRules = make([]policy.CustomRule, 0, len(custRules))
@@ -736,6 +751,9 @@ func parsePolicyComplianceContainer(d *schema.ResourceData, id string) policyCom
 					rule.Processes.Whitelist = processItem["whitelist"].([]string)
 				}
 			}
+			if item["tags"] != nil {
+				rule.Tags = parseTags(item["tags"].([]interface{}))
+			}
 			if item["wildfireanalysis"] != nil {
 				rule.WildFireAnalysis = item["wildfireanalysis"].(string)
 			}
@@ -751,6 +769,7 @@ func savePolicyComplianceContainer(d *schema.ResourceData, obj policyComplianceC
 	d.Set("_id", obj.PolicyId)
 	d.Set("policytype", obj.PolicyType)
 	d.Set("rule", obj.Rules)
+	initRulesModified(d, obj.Rules)
 
 	// Rule.
 	if len(obj.Rules) > 0 {
@@ -775,15 +794,17 @@ func savePolicyComplianceContainer(d *schema.ResourceData, obj policyComplianceC
 		}
 
 		if err := d.Set("rule", []interface{}{rv}); err != nil {
-			log.Printf("[WARN] Error setting 'rule' for %q: %s", d.Id(), err)
+			logWarn("error setting field", "field", "rule", "id", d.Id(), "error", err)
 		}
 	}
 
 }
 
 func createPolicyComplianceContainer(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	obj := parsePolicyComplianceContainer(d, "")
+	obj.Rules = client.activeExceptions(obj.Rules)
+	obj.Rules = expandComplianceTemplates(d, obj.Rules)
 
 	if err := policyComplianceContainer.Create(client, obj); err != nil {
 		return err
@@ -800,11 +821,15 @@ func createPolicyComplianceContainer(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(pol.PolicyId)
-	return readPolicyComplianceContainer(d, meta)
+	if err := readPolicyComplianceContainer(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyComplianceContainerRules)
 }
 
 func readPolicyComplianceContainer(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	obj, err := policyComplianceContainer.Get(client)
 	if err != nil {
@@ -820,20 +845,35 @@ func readPolicyComplianceContainer(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func policyComplianceContainerRules(c *apiClient) ([]policy.Rule, error) {
+	pol, err := policyComplianceContainer.Get(c)
+	return pol.Rules, err
+}
+
 func updatePolicyComplianceContainer(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	id := d.Id()
 	obj := parsePolicyComplianceContainer(d, id)
+	obj.Rules = client.activeExceptions(obj.Rules)
+	obj.Rules = expandComplianceTemplates(d, obj.Rules)
+
+	if err := client.checkRulesUnchanged(d, "compliance container", policyComplianceContainerRules); err != nil {
+		return err
+	}
 
 	if err := policyComplianceContainer.Update(client, obj); err != nil {
 		return err
 	}
 
-	return readPolicyComplianceContainer(d, meta)
+	if err := readPolicyComplianceContainer(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyComplianceContainerRules)
 }
 
 func deletePolicyComplianceContainer(d *schema.ResourceData, meta interface{}) error {
-	/*	client := meta.(*pc.Client)
+	/*	client := meta.(*apiClient)
 		id := d.Id()
 
 		err := policy.Delete(client, id)
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"testing"
 
-	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyComplianceContainer"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -108,7 +107,7 @@ func testAccCheckPolicyComplianceContainerExists(n string, o *policyComplianceCo
 			return fmt.Errorf("Object label Id is not set")
 		}
 
-		client := testAccProvider.Meta().(*pc.Client)
+		client := testAccProvider.Meta().(*apiClient)
 		lo, err := policyComplianceContainer.Get(client)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
@@ -136,7 +135,7 @@ func testAccCheckPolicyComplianceContainerAttributes(o *policyComplianceContaine
 }
 
 func testAccPolicyComplianceContainerDestroy(s *terraform.State) error {
-	/*	client := testAccProvider.Meta().(*pc.Client)
+	/*	client := testAccProvider.Meta().(*apiClient)
 
 		for _, rs := range s.RootModule().Resources {
 
@@ -1,12 +1,13 @@
 package prismacloudcompute
 
 import (
-	"log"
 	"time"
 
 	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyComplianceHost"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -16,6 +17,11 @@ func resourcePoliciesComplianceHost() *schema.Resource {
 		Read:   readPolicyComplianceHost,
 		Update: updatePolicyComplianceHost,
 		Delete: deletePolicyComplianceHost,
+		CustomizeDiff: customdiff.All(
+			customizeExceptionExpiration("rules"),
+			customizeComplianceTemplates("rules"),
+			customizeRulesUnchanged("compliance host", policyComplianceHostRules),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -28,6 +34,10 @@ func resourcePoliciesComplianceHost() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"project":         projectSchema(),
+			"force_overwrite": forceOverwriteSchema(),
+			"rules_modified":  rulesModifiedSchema(),
+			"template_checks": templateChecksSchema(),
 			"_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -303,9 +313,10 @@ func resourcePoliciesComplianceHost() *schema.Resource {
 										Description: "CVE ID",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The compliance container expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The compliance container expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -491,9 +502,10 @@ func resourcePoliciesComplianceHost() *schema.Resource {
 										Description: "Specifies the relevant action for a compliance container. Can be set to 'ignore', 'alert', or 'block'.",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The compliance container expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The compliance container expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -517,6 +529,7 @@ func resourcePoliciesComplianceHost() *schema.Resource {
 								},
 							},
 						},
+						"template": complianceTemplateSchema(),
 						"verbose": {
 							Type:        schema.TypeBool,
 							Optional:    true,
@@ -547,6 +560,7 @@ func savePolicyComplianceHost(d *schema.ResourceData, obj policyComplianceHost.P
 	d.Set("_id", obj.PolicyId)
 	d.Set("policytype", obj.PolicyType)
 	d.Set("rules", obj.Rules)
+	initRulesModified(d, obj.Rules)
 
 	// Rule.
 	if len(obj.Rules) > 0 {
@@ -570,15 +584,17 @@ func savePolicyComplianceHost(d *schema.ResourceData, obj policyComplianceHost.P
 		}
 
 		if err := d.Set("rules", []interface{}{rv}); err != nil {
-			log.Printf("[WARN] Error setting 'rules' for %q: %s", d.Id(), err)
+			logWarn("error setting field", "field", "rules", "id", d.Id(), "error", err)
 		}
 	}
 
 }
 
 func createPolicyComplianceHost(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	obj := parsePolicyComplianceHost(d, "")
+	obj.Rules = client.activeExceptions(obj.Rules)
+	obj.Rules = expandComplianceTemplates(d, obj.Rules)
 
 	if err := policyComplianceHost.Create(client, obj); err != nil {
 		return err
@@ -595,11 +611,15 @@ func createPolicyComplianceHost(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.SetId(pol.PolicyId)
-	return readPolicyComplianceHost(d, meta)
+	if err := readPolicyComplianceHost(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyComplianceHostRules)
 }
 
 func readPolicyComplianceHost(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	obj, err := policyComplianceHost.Get(client)
 	if err != nil {
@@ -615,20 +635,35 @@ func readPolicyComplianceHost(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func policyComplianceHostRules(c *apiClient) ([]policy.Rule, error) {
+	pol, err := policyComplianceHost.Get(c)
+	return pol.Rules, err
+}
+
 func updatePolicyComplianceHost(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	id := d.Id()
 	obj := parsePolicyComplianceHost(d, id)
+	obj.Rules = client.activeExceptions(obj.Rules)
+	obj.Rules = expandComplianceTemplates(d, obj.Rules)
+
+	if err := client.checkRulesUnchanged(d, "compliance host", policyComplianceHostRules); err != nil {
+		return err
+	}
 
 	if err := policyComplianceHost.Update(client, obj); err != nil {
 		return err
 	}
 
-	return readPolicyComplianceHost(d, meta)
+	if err := readPolicyComplianceHost(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyComplianceHostRules)
 }
 
 func deletePolicyComplianceHost(d *schema.ResourceData, meta interface{}) error {
-	/*	client := meta.(*pc.Client)
+	/*	client := meta.(*apiClient)
 		id := d.Id()
 
 		err := policy.Delete(client, id)
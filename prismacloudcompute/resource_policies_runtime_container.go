@@ -1,7 +1,6 @@
 package prismacloudcompute
 
 import (
-	"log"
 	"time"
 
 	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
@@ -14,10 +13,11 @@ import (
 
 func resourcePoliciesRuntimeContainer() *schema.Resource {
 	return &schema.Resource{
-		Create: createPolicy,
-		Read:   readPolicy,
-		Update: updatePolicy,
-		Delete: deletePolicy,
+		Create:        createPolicy,
+		Read:          readPolicy,
+		Update:        updatePolicy,
+		Delete:        deletePolicy,
+		CustomizeDiff: customizeRulesUnchanged("runtime container", policyRuntimeContainerRules),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -30,6 +30,9 @@ func resourcePoliciesRuntimeContainer() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"project":         projectSchema(),
+			"force_overwrite": forceOverwriteSchema(),
+			"rules_modified":  rulesModifiedSchema(),
 			"_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -777,6 +780,7 @@ func savePolicy(d *schema.ResourceData, obj policyRuntimeContainer.Policy) {
 	d.Set("_id", obj.PolicyId)
 	d.Set("learningdisabled", obj.LearningDisabled)
 	d.Set("rule", obj.Rules)
+	initRulesModified(d, obj.Rules)
 
 	// Rule.
 	if len(obj.Rules) > 0 {
@@ -800,14 +804,14 @@ func savePolicy(d *schema.ResourceData, obj policyRuntimeContainer.Policy) {
 		}
 
 		if err := d.Set("rule", []interface{}{rv}); err != nil {
-			log.Printf("[WARN] Error setting 'rule' for %q: %s", d.Id(), err)
+			logWarn("error setting field", "field", "rule", "id", d.Id(), "error", err)
 		}
 	}
 
 }
 
 func createPolicy(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	obj := parsePolicy(d, "")
 
 	if err := policyRuntimeContainer.Create(client, obj); err != nil {
@@ -825,11 +829,15 @@ func createPolicy(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(pol.PolicyId)
-	return readPolicy(d, meta)
+	if err := readPolicy(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyRuntimeContainerRules)
 }
 
 func readPolicy(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	obj, err := policyRuntimeContainer.Get(client)
 	if err != nil {
@@ -845,20 +853,33 @@ func readPolicy(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func policyRuntimeContainerRules(c *apiClient) ([]policy.Rule, error) {
+	pol, err := policyRuntimeContainer.Get(c)
+	return pol.Rules, err
+}
+
 func updatePolicy(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	id := d.Id()
 	obj := parsePolicy(d, id)
 
+	if err := client.checkRulesUnchanged(d, "runtime container", policyRuntimeContainerRules); err != nil {
+		return err
+	}
+
 	if err := policyRuntimeContainer.Update(client, obj); err != nil {
 		return err
 	}
 
-	return readPolicy(d, meta)
+	if err := readPolicy(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyRuntimeContainerRules)
 }
 
 func deletePolicy(d *schema.ResourceData, meta interface{}) error {
-	/*	client := meta.(*pc.Client)
+	/*	client := meta.(*apiClient)
 		id := d.Id()
 
 		err := policy.Delete(client, id)
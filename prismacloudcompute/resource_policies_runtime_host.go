@@ -1,10 +1,10 @@
 package prismacloudcompute
 
 import (
-	"log"
 	"time"
 
 	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyRuntimeHost"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -12,10 +12,11 @@ import (
 
 func resourcePoliciesRuntimeHost() *schema.Resource {
 	return &schema.Resource{
-		Create: createPolicy,
-		Read:   readPolicy,
-		Update: updatePolicy,
-		Delete: deletePolicy,
+		Create:        createPolicyRuntimeHost,
+		Read:          readPolicyRuntimeHost,
+		Update:        updatePolicyRuntimeHost,
+		Delete:        deletePolicyRuntimeHost,
+		CustomizeDiff: customizeRulesUnchanged("runtime host", policyRuntimeHostRules),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -28,6 +29,9 @@ func resourcePoliciesRuntimeHost() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"force_overwrite": forceOverwriteSchema(),
+			"rules_modified":  rulesModifiedSchema(),
 			"_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -682,6 +686,7 @@ func savePolicyRuntimeHost(d *schema.ResourceData, obj policyRuntimeHost.Policy)
 	d.Set("_id", obj.PolicyId)
 	d.Set("owner", obj.Owner)
 	d.Set("rules", obj.Rules)
+	initRulesModified(d, obj.Rules)
 
 	// Rule.
 	if len(obj.Rules) > 0 {
@@ -705,14 +710,14 @@ func savePolicyRuntimeHost(d *schema.ResourceData, obj policyRuntimeHost.Policy)
 		}
 
 		if err := d.Set("rules", []interface{}{rv}); err != nil {
-			log.Printf("[WARN] Error setting 'rules' for %q: %s", d.Id(), err)
+			logWarn("error setting field", "field", "rules", "id", d.Id(), "error", err)
 		}
 	}
 
 }
 
 func createPolicyRuntimeHost(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	obj := parsePolicyRuntimeHost(d, "")
 
 	if err := policyRuntimeHost.Create(client, obj); err != nil {
@@ -730,11 +735,15 @@ func createPolicyRuntimeHost(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(pol.PolicyId)
-	return readPolicyRuntimeHost(d, meta)
+	if err := readPolicyRuntimeHost(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyRuntimeHostRules)
 }
 
 func readPolicyRuntimeHost(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	obj, err := policyRuntimeHost.Get(client)
 	if err != nil {
@@ -750,20 +759,33 @@ func readPolicyRuntimeHost(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func policyRuntimeHostRules(c *apiClient) ([]policy.Rule, error) {
+	pol, err := policyRuntimeHost.Get(c)
+	return pol.Rules, err
+}
+
 func updatePolicyRuntimeHost(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	id := d.Id()
 	obj := parsePolicyRuntimeHost(d, id)
 
+	if err := client.checkRulesUnchanged(d, "runtime host", policyRuntimeHostRules); err != nil {
+		return err
+	}
+
 	if err := policyRuntimeHost.Update(client, obj); err != nil {
 		return err
 	}
 
-	return readPolicyRuntimeHost(d, meta)
+	if err := readPolicyRuntimeHost(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyRuntimeHostRules)
 }
 
 func deletePolicyRuntimeHost(d *schema.ResourceData, meta interface{}) error {
-	/*	client := meta.(*pc.Client)
+	/*	client := meta.(*apiClient)
 		id := d.Id()
 
 		err := policy.Delete(client, id)
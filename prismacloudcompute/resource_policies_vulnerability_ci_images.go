@@ -1,12 +1,13 @@
 package prismacloudcompute
 
 import (
-	"log"
 	"time"
 
 	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyVulnerabilityCiImages"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -16,6 +17,10 @@ func resourcePoliciesVulnerabilityCiImages() *schema.Resource {
 		Read:   readPolicyVulnerabilityCiImages,
 		Update: updatePolicyVulnerabilityCiImages,
 		Delete: deletePolicyVulnerabilityCiImages,
+		CustomizeDiff: customdiff.All(
+			customizeExceptionExpiration("rule"),
+			customizeRulesUnchanged("vulnerability CI images", policyVulnerabilityCiImagesRules),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -28,6 +33,9 @@ func resourcePoliciesVulnerabilityCiImages() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"project":         projectSchema(),
+			"force_overwrite": forceOverwriteSchema(),
+			"rules_modified":  rulesModifiedSchema(),
 			"_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -265,9 +273,10 @@ func resourcePoliciesVulnerabilityCiImages() *schema.Resource {
 										Description: "CVE ID",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The vulnerability expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The vulnerability expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -453,9 +462,10 @@ func resourcePoliciesVulnerabilityCiImages() *schema.Resource {
 										Description: "Specifies the relevant action for a vulnerability. Can be set to 'ignore', 'alert', or 'block'.",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The vulnerability expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The vulnerability expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -509,6 +519,7 @@ func savePolicyVulnerabilityCiImages(d *schema.ResourceData, obj policyVulnerabi
 	d.Set("_id", obj.PolicyId)
 	d.Set("policytype", obj.PolicyType)
 	d.Set("rule", obj.Rules)
+	initRulesModified(d, obj.Rules)
 
 	// Rule.
 	if len(obj.Rules) > 0 {
@@ -534,15 +545,16 @@ func savePolicyVulnerabilityCiImages(d *schema.ResourceData, obj policyVulnerabi
 		}
 
 		if err := d.Set("rule", []interface{}{rv}); err != nil {
-			log.Printf("[WARN] Error setting 'rule' for %q: %s", d.Id(), err)
+			logWarn("error setting field", "field", "rule", "id", d.Id(), "error", err)
 		}
 	}
 
 }
 
 func createPolicyVulnerabilityCiImages(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	obj := parsePolicyVulnerabilityCiImages(d, "")
+	obj.Rules = client.activeExceptions(obj.Rules)
 
 	if err := policyVulnerabilityCiImages.Create(client, obj); err != nil {
 		return err
@@ -559,11 +571,15 @@ func createPolicyVulnerabilityCiImages(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(pol.PolicyId)
-	return readPolicyVulnerabilityCiImages(d, meta)
+	if err := readPolicyVulnerabilityCiImages(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyVulnerabilityCiImagesRules)
 }
 
 func readPolicyVulnerabilityCiImages(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	obj, err := policyVulnerabilityCiImages.Get(client)
 	if err != nil {
@@ -579,20 +595,34 @@ func readPolicyVulnerabilityCiImages(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func policyVulnerabilityCiImagesRules(c *apiClient) ([]policy.Rule, error) {
+	pol, err := policyVulnerabilityCiImages.Get(c)
+	return pol.Rules, err
+}
+
 func updatePolicyVulnerabilityCiImages(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	id := d.Id()
 	obj := parsePolicyVulnerabilityCiImages(d, id)
+	obj.Rules = client.activeExceptions(obj.Rules)
+
+	if err := client.checkRulesUnchanged(d, "vulnerability CI images", policyVulnerabilityCiImagesRules); err != nil {
+		return err
+	}
 
 	if err := policyVulnerabilityCiImages.Update(client, obj); err != nil {
 		return err
 	}
 
-	return readPolicyVulnerabilityCiImages(d, meta)
+	if err := readPolicyVulnerabilityCiImages(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyVulnerabilityCiImagesRules)
 }
 
 func deletePolicyVulnerabilityCiImages(d *schema.ResourceData, meta interface{}) error {
-	/*	client := meta.(*pc.Client)
+	/*	client := meta.(*apiClient)
 		id := d.Id()
 
 		err := policy.Delete(client, id)
@@ -1,12 +1,13 @@
 package prismacloudcompute
 
 import (
-	"log"
 	"time"
 
 	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyVulnerabilityHost"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -16,6 +17,10 @@ func resourcePoliciesVulnerabilityHost() *schema.Resource {
 		Read:   readPolicyVulnerabilityHost,
 		Update: updatePolicyVulnerabilityHost,
 		Delete: deletePolicyVulnerabilityHost,
+		CustomizeDiff: customdiff.All(
+			customizeExceptionExpiration("rules"),
+			customizeRulesUnchanged("vulnerability host", policyVulnerabilityHostRules),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -28,6 +33,9 @@ func resourcePoliciesVulnerabilityHost() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"project":         projectSchema(),
+			"force_overwrite": forceOverwriteSchema(),
+			"rules_modified":  rulesModifiedSchema(),
 			"_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -303,9 +311,10 @@ func resourcePoliciesVulnerabilityHost() *schema.Resource {
 										Description: "CVE ID",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The vulnerability expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The vulnerability expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -491,9 +500,10 @@ func resourcePoliciesVulnerabilityHost() *schema.Resource {
 										Description: "Specifies the relevant action for a vulnerability. Can be set to 'ignore', 'alert', or 'block'.",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The vulnerability expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The vulnerability expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -547,6 +557,7 @@ func savePolicyVulnerabilityHost(d *schema.ResourceData, obj policyVulnerability
 	d.Set("_id", obj.PolicyId)
 	d.Set("policytype", obj.PolicyType)
 	d.Set("rules", obj.Rules)
+	initRulesModified(d, obj.Rules)
 
 	// Rule.
 	if len(obj.Rules) > 0 {
@@ -572,15 +583,16 @@ func savePolicyVulnerabilityHost(d *schema.ResourceData, obj policyVulnerability
 		}
 
 		if err := d.Set("rules", []interface{}{rv}); err != nil {
-			log.Printf("[WARN] Error setting 'rules' for %q: %s", d.Id(), err)
+			logWarn("error setting field", "field", "rules", "id", d.Id(), "error", err)
 		}
 	}
 
 }
 
 func createPolicyVulnerabilityHost(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	obj := parsePolicyVulnerabilityHost(d, "")
+	obj.Rules = client.activeExceptions(obj.Rules)
 
 	if err := policyVulnerabilityHost.Create(client, obj); err != nil {
 		return err
@@ -597,11 +609,15 @@ func createPolicyVulnerabilityHost(d *schema.ResourceData, meta interface{}) err
 	}
 
 	d.SetId(pol.PolicyId)
-	return readPolicyVulnerabilityHost(d, meta)
+	if err := readPolicyVulnerabilityHost(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyVulnerabilityHostRules)
 }
 
 func readPolicyVulnerabilityHost(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	obj, err := policyVulnerabilityHost.Get(client)
 	if err != nil {
@@ -617,20 +633,34 @@ func readPolicyVulnerabilityHost(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func policyVulnerabilityHostRules(c *apiClient) ([]policy.Rule, error) {
+	pol, err := policyVulnerabilityHost.Get(c)
+	return pol.Rules, err
+}
+
 func updatePolicyVulnerabilityHost(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	id := d.Id()
 	obj := parsePolicyVulnerabilityHost(d, id)
+	obj.Rules = client.activeExceptions(obj.Rules)
+
+	if err := client.checkRulesUnchanged(d, "vulnerability host", policyVulnerabilityHostRules); err != nil {
+		return err
+	}
 
 	if err := policyVulnerabilityHost.Update(client, obj); err != nil {
 		return err
 	}
 
-	return readPolicyVulnerabilityHost(d, meta)
+	if err := readPolicyVulnerabilityHost(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyVulnerabilityHostRules)
 }
 
 func deletePolicyVulnerabilityHost(d *schema.ResourceData, meta interface{}) error {
-	/*	client := meta.(*pc.Client)
+	/*	client := meta.(*apiClient)
 		id := d.Id()
 
 		err := policy.Delete(client, id)
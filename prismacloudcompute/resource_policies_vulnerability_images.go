@@ -1,7 +1,6 @@
 package prismacloudcompute
 
 import (
-	"log"
 	"time"
 
 	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
@@ -9,6 +8,7 @@ import (
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy"
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/policy/policyVulnerabilityImages"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -18,6 +18,10 @@ func resourcePoliciesVulnerabilityImages() *schema.Resource {
 		Read:   readPolicyVulnerabilityImages,
 		Update: updatePolicyVulnerabilityImages,
 		Delete: deletePolicyVulnerabilityImages,
+		CustomizeDiff: customdiff.All(
+			customizeExceptionExpiration("rule"),
+			customizeRulesUnchanged("vulnerability images", policyVulnerabilityImagesRules),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -30,6 +34,9 @@ func resourcePoliciesVulnerabilityImages() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"project":         projectSchema(),
+			"force_overwrite": forceOverwriteSchema(),
+			"rules_modified":  rulesModifiedSchema(),
 			"_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -305,9 +312,10 @@ func resourcePoliciesVulnerabilityImages() *schema.Resource {
 										Description: "CVE ID",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The vulnerability expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The vulnerability expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -493,9 +501,10 @@ func resourcePoliciesVulnerabilityImages() *schema.Resource {
 										Description: "Specifies the relevant action for a vulnerability. Can be set to 'ignore', 'alert', or 'block'.",
 									},
 									"expiration": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Description: "The vulnerability expiration date.",
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Description:  "The vulnerability expiration date.",
+										ValidateFunc: validateExceptionExpiration,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"date": {
@@ -581,6 +590,9 @@ func parsePolicyVulnerabilityImages(d *schema.ResourceData, id string) policyVul
 					condition.Vulnerabilities = append(condition.Vulnerabilities, vulnerabilities)
 				}
 			}
+			if item["cverules"] != nil {
+				rule.CveRules = parseCveRules(item["cverules"].([]interface{}))
+			}
 			if item["customrules"] != nil {
 				custRules := item["customrules"].([]interface{})
 				rule.CustomRules = make([]policy.CustomRule, 0, len(custRules))
@@ -737,6 +749,9 @@ func parsePolicyVulnerabilityImages(d *schema.ResourceData, id string) policyVul
 					rule.Processes.Whitelist = processItem["whitelist"].([]string)
 				}
 			}
+			if item["tags"] != nil {
+				rule.Tags = parseTags(item["tags"].([]interface{}))
+			}
 			if item["wildfireanalysis"] != nil {
 				rule.WildFireAnalysis = item["wildfireanalysis"].(string)
 			}
@@ -752,6 +767,7 @@ func savePolicyVulnerabilityImages(d *schema.ResourceData, obj policyVulnerabili
 	d.Set("_id", obj.PolicyId)
 	d.Set("policytype", obj.PolicyType)
 	d.Set("rule", obj.Rules)
+	initRulesModified(d, obj.Rules)
 
 	// Rule.
 	if len(obj.Rules) > 0 {
@@ -777,15 +793,16 @@ func savePolicyVulnerabilityImages(d *schema.ResourceData, obj policyVulnerabili
 		}
 
 		if err := d.Set("rule", []interface{}{rv}); err != nil {
-			log.Printf("[WARN] Error setting 'rule' for %q: %s", d.Id(), err)
+			logWarn("error setting field", "field", "rule", "id", d.Id(), "error", err)
 		}
 	}
 
 }
 
 func createPolicyVulnerabilityImages(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	obj := parsePolicyVulnerabilityImages(d, "")
+	obj.Rules = client.activeExceptions(obj.Rules)
 
 	if err := policyVulnerabilityImages.Create(client, obj); err != nil {
 		return err
@@ -802,11 +819,15 @@ func createPolicyVulnerabilityImages(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(pol.PolicyId)
-	return readPolicyVulnerabilityImages(d, meta)
+	if err := readPolicyVulnerabilityImages(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyVulnerabilityImagesRules)
 }
 
 func readPolicyVulnerabilityImages(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 
 	obj, err := policyVulnerabilityImages.Get(client)
 	if err != nil {
@@ -822,20 +843,34 @@ func readPolicyVulnerabilityImages(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func policyVulnerabilityImagesRules(c *apiClient) ([]policy.Rule, error) {
+	pol, err := policyVulnerabilityImages.Get(c)
+	return pol.Rules, err
+}
+
 func updatePolicyVulnerabilityImages(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*pc.Client)
+	client := meta.(*apiClient).withProject(d)
 	id := d.Id()
 	obj := parsePolicyVulnerabilityImages(d, id)
+	obj.Rules = client.activeExceptions(obj.Rules)
+
+	if err := client.checkRulesUnchanged(d, "vulnerability images", policyVulnerabilityImagesRules); err != nil {
+		return err
+	}
 
 	if err := policyVulnerabilityImages.Update(client, obj); err != nil {
 		return err
 	}
 
-	return readPolicyVulnerabilityImages(d, meta)
+	if err := readPolicyVulnerabilityImages(d, meta); err != nil {
+		return err
+	}
+
+	return client.recordRulesModified(d, policyVulnerabilityImagesRules)
 }
 
 func deletePolicyVulnerabilityImages(d *schema.ResourceData, meta interface{}) error {
-	/*	client := meta.(*pc.Client)
+	/*	client := meta.(*apiClient)
 		id := d.Id()
 
 		err := policy.Delete(client, id)
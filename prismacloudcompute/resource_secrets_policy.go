@@ -0,0 +1,273 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/collection"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/policySecrets"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSecretsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSecretsPolicy,
+		Read:   readSecretsPolicy,
+		Update: updateSecretsPolicy,
+		Delete: deleteSecretsPolicy,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"rules": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "List of rules, each injecting secrets into the containers of some collections.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the rule.",
+						},
+						"collections": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Names of the collections whose containers the secrets are injected into.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"secrets": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Secrets to inject.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the environment variable or file the secret is injected as.",
+									},
+									"store": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the secrets store that holds the secret.",
+									},
+									"path": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Path of the secret in the store.",
+									},
+								},
+							},
+						},
+						"injection": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     policySecrets.InjectionEnvironment,
+							Description: "How secrets are injected. Can be set to 'environment' or 'file'.",
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									policySecrets.InjectionEnvironment,
+									policySecrets.InjectionFile,
+								},
+								false,
+							),
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Directory that secrets injected as files are written to.",
+						},
+						"read_all_perm": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Make secrets injected as files readable by all users of the container.",
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "If set to 'true', the rule is currently disabled.",
+						},
+						"notes": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A free-form text description of the rule.",
+						},
+						"owner": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User who created or last modified the rule.",
+						},
+						"modified": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date/time when the rule was last modified.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// parseSecretsPolicy builds the policy, looking up the collections that
+// rules refer to by name.
+func parseSecretsPolicy(d *schema.ResourceData, collections []collection.Collection) (policySecrets.Policy, error) {
+	byName := make(map[string]collection.Collection, len(collections))
+	for _, coll := range collections {
+		byName[coll.Name] = coll
+	}
+
+	obj := policySecrets.Policy{
+		Id: d.Id(),
+	}
+
+	for _, item := range d.Get("rules").([]interface{}) {
+		x := item.(map[string]interface{})
+		rule := policySecrets.Rule{
+			Name:        x["name"].(string),
+			Injection:   x["injection"].(string),
+			Target:      x["target"].(string),
+			ReadAllPerm: x["read_all_perm"].(bool),
+			Disabled:    x["disabled"].(bool),
+			Notes:       x["notes"].(string),
+		}
+
+		for _, name := range ListToStringSlice(x["collections"].([]interface{})) {
+			coll, ok := byName[name]
+			if !ok {
+				return obj, fmt.Errorf("collection %q of secrets rule %q does not exist", name, rule.Name)
+			}
+			rule.Collections = append(rule.Collections, coll)
+		}
+
+		for _, v := range x["secrets"].([]interface{}) {
+			s := v.(map[string]interface{})
+			rule.Secrets = append(rule.Secrets, policySecrets.Secret{
+				Name:  s["name"].(string),
+				Store: s["store"].(string),
+				Path:  s["path"].(string),
+			})
+		}
+
+		obj.Rules = append(obj.Rules, rule)
+	}
+
+	return obj, nil
+}
+
+func saveSecretsPolicy(d *schema.ResourceData, obj policySecrets.Policy) {
+	rules := make([]interface{}, 0, len(obj.Rules))
+	for _, rule := range obj.Rules {
+		collections := make([]interface{}, 0, len(rule.Collections))
+		for _, coll := range rule.Collections {
+			collections = append(collections, coll.Name)
+		}
+
+		secrets := make([]interface{}, 0, len(rule.Secrets))
+		for _, s := range rule.Secrets {
+			secrets = append(secrets, map[string]interface{}{
+				"name":  s.Name,
+				"store": s.Store,
+				"path":  s.Path,
+			})
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"name":          rule.Name,
+			"collections":   collections,
+			"secrets":       secrets,
+			"injection":     rule.Injection,
+			"target":        rule.Target,
+			"read_all_perm": rule.ReadAllPerm,
+			"disabled":      rule.Disabled,
+			"notes":         rule.Notes,
+			"owner":         rule.Owner,
+			"modified":      rule.Modified,
+		})
+	}
+
+	d.Set("rules", rules)
+}
+
+func readSecretsPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := policySecrets.Get(client)
+	if err != nil {
+		if err == pc.ObjectNotFoundError {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	saveSecretsPolicy(d, obj)
+
+	return nil
+}
+
+func updateSecretsPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	collections, err := collection.List(client)
+	if err != nil {
+		return err
+	}
+
+	obj, err := parseSecretsPolicy(d, collections)
+	if err != nil {
+		return err
+	}
+
+	if err := policySecrets.Update(client, obj); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		pol, err := policySecrets.Get(client)
+		if err != nil {
+			return err
+		}
+		if pol.Id == "" {
+			pol.Id = "secrets"
+		}
+		d.SetId(pol.Id)
+	}
+
+	return readSecretsPolicy(d, meta)
+}
+
+// deleteSecretsPolicy removes all rules, so that no secrets are injected
+// anymore.
+func deleteSecretsPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj := policySecrets.Policy{
+		Id:    d.Id(),
+		Rules: []policySecrets.Rule{},
+	}
+	if err := policySecrets.Update(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
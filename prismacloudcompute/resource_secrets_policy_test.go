@@ -0,0 +1,66 @@
+package prismacloudcompute
+
+import (
+	"testing"
+
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/collection"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/policySecrets"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestParseSecretsPolicy(t *testing.T) {
+	raw := func(collections ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"rules": []interface{}{
+				map[string]interface{}{
+					"name":        "payments",
+					"collections": collections,
+					"injection":   "file",
+					"target":      "/run/secrets",
+					"secrets": []interface{}{
+						map[string]interface{}{
+							"name":  "DB_PASSWORD",
+							"store": "vault",
+							"path":  "secret/data/payments#password",
+						},
+					},
+				},
+			},
+		}
+	}
+	collections := []collection.Collection{
+		{Name: "All"},
+		{Name: "payments", Images: []string{"registry.example.com/payments/*"}},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSecretsPolicy().Schema, raw("payments"))
+	obj, err := parseSecretsPolicy(d, collections)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(obj.Rules) != 1 {
+		t.Fatalf("got %d rules, expected 1", len(obj.Rules))
+	}
+	rule := obj.Rules[0]
+	if len(rule.Collections) != 1 || len(rule.Collections[0].Images) != 1 {
+		t.Errorf("collections are %#v, expected the full payments collection", rule.Collections)
+	}
+	if rule.Injection != policySecrets.InjectionFile || rule.Target != "/run/secrets" {
+		t.Errorf("injection is %q into %q", rule.Injection, rule.Target)
+	}
+	if len(rule.Secrets) != 1 || rule.Secrets[0].Store != "vault" {
+		t.Errorf("secrets are %#v", rule.Secrets)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSecretsPolicy().Schema, raw("missing"))
+	if _, err := parseSecretsPolicy(d, collections); err == nil {
+		t.Error("expected an error for a collection that does not exist")
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSecretsPolicy().Schema, map[string]interface{}{})
+	saveSecretsPolicy(d, obj)
+	if again, err := parseSecretsPolicy(d, collections); err != nil || len(again.Rules) != 1 || again.Rules[0].Secrets[0].Path != "secret/data/payments#password" {
+		t.Errorf("policy did not survive a round trip: %#v, %v", again, err)
+	}
+}
@@ -0,0 +1,182 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/secretsStore"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// secretsStoreArgs lists the arguments each type of secrets store needs.
+var secretsStoreArgs = map[string][]string{
+	secretsStore.TypeVault:             {"url", "credential_id"},
+	secretsStore.TypeAwsSecretsManager: {"region", "credential_id"},
+	secretsStore.TypeAzureKeyVault:     {"url", "credential_id"},
+	secretsStore.TypeCyberArk:          {"url", "app_id"},
+}
+
+func resourceSecretsStore() *schema.Resource {
+	return &schema.Resource{
+		Create:        createSecretsStore,
+		Read:          readSecretsStore,
+		Update:        updateSecretsStore,
+		Delete:        deleteSecretsStore,
+		CustomizeDiff: customizeSecretsStore,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name of the store, as referenced by secrets policy rules.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Type of the store. Can be set to 'hashicorpVault', 'awsSecretsManager', 'azureKeyVault', or 'cyberArk'.",
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						secretsStore.TypeVault,
+						secretsStore.TypeAwsSecretsManager,
+						secretsStore.TypeAzureKeyVault,
+						secretsStore.TypeCyberArk,
+					},
+					false,
+				),
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "URL of the store. Required for Vault, Azure Key Vault and CyberArk stores.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AWS region of the store. Required for AWS Secrets Manager stores.",
+			},
+			"credential_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the credential, in the Console's credentials store, used to access the store. Required for Vault, AWS Secrets Manager and Azure Key Vault stores.",
+			},
+			"app_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Application ID the Console identifies itself with. Required for CyberArk stores.",
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM-encoded CA certificate of the store, for stores with certificates from a private CA.",
+			},
+		},
+	}
+}
+
+func customizeSecretsStore(d *schema.ResourceDiff, meta interface{}) error {
+	typ := d.Get("type").(string)
+	for _, key := range secretsStoreArgs[typ] {
+		if d.NewValueKnown(key) && d.Get(key).(string) == "" {
+			return fmt.Errorf("%q is required for %s secrets stores", key, typ)
+		}
+	}
+
+	return nil
+}
+
+func parseSecretsStore(d *schema.ResourceData) secretsStore.Store {
+	return secretsStore.Store{
+		Name:         d.Get("name").(string),
+		Type:         d.Get("type").(string),
+		Url:          d.Get("url").(string),
+		Region:       d.Get("region").(string),
+		CredentialId: d.Get("credential_id").(string),
+		AppId:        d.Get("app_id").(string),
+		CaCert:       d.Get("ca_cert").(string),
+	}
+}
+
+func saveSecretsStore(d *schema.ResourceData, obj secretsStore.Store) {
+	d.Set("name", obj.Name)
+	d.Set("type", obj.Type)
+	d.Set("url", obj.Url)
+	d.Set("region", obj.Region)
+	d.Set("credential_id", obj.CredentialId)
+	d.Set("app_id", obj.AppId)
+	d.Set("ca_cert", obj.CaCert)
+}
+
+func createSecretsStore(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSecretsStore(d)
+
+	if err := secretsStore.Create(client, obj); err != nil {
+		return err
+	}
+
+	PollApiUntilSuccess(func() error {
+		_, err := secretsStore.Get(client, obj.Name)
+		return err
+	})
+
+	d.SetId(obj.Name)
+	return readSecretsStore(d, meta)
+}
+
+func readSecretsStore(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := secretsStore.Get(client, d.Id())
+	if err != nil {
+		if err == pc.ObjectNotFoundError {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	saveSecretsStore(d, obj)
+
+	return nil
+}
+
+func updateSecretsStore(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSecretsStore(d)
+
+	if err := secretsStore.Update(client, obj); err != nil {
+		return err
+	}
+
+	return readSecretsStore(d, meta)
+}
+
+func deleteSecretsStore(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := secretsStore.Delete(client, d.Id()); err != nil {
+		if err != pc.ObjectNotFoundError {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,104 @@
+package prismacloudcompute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/secretsStore"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestSecretsStoreConcurrentChanges(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		doc = secretsStore.Settings{
+			SecretsStores: []secretsStore.Store{
+				{Name: "manual", Type: secretsStore.TypeAwsSecretsManager, Region: "us-east-1", CredentialId: "aws"},
+			},
+		}
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/api/v1/authenticate":
+			fmt.Fprint(w, `{"token": "opaque"}`)
+		case r.URL.Path == "/api/v1/settings/secrets" && r.Method == "GET":
+			json.NewEncoder(w).Encode(doc)
+		case r.URL.Path == "/api/v1/settings/secrets" && r.Method == "POST":
+			doc = secretsStore.Settings{}
+			json.NewDecoder(r.Body).Decode(&doc)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := newApiClient(&pc.Client{
+		Url:      strings.TrimPrefix(srv.URL, "http://"),
+		Protocol: "http",
+		Username: "admin",
+		Password: "admin",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{"vault-a", "vault-b", "vault-c"}
+	data := make([]*schema.ResourceData, len(names))
+	errs := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		data[i] = schema.TestResourceDataRaw(t, resourceSecretsStore().Schema, map[string]interface{}{
+			"name":          name,
+			"type":          secretsStore.TypeVault,
+			"url":           "https://vault.example.com",
+			"credential_id": "vault-token",
+		})
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = createSecretsStore(data[i], client)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("creating %s: %s", names[i], err)
+		}
+		if data[i].Id() != names[i] {
+			t.Errorf("ID is %q, expected %q", data[i].Id(), names[i])
+		}
+	}
+	if len(doc.SecretsStores) != 4 {
+		t.Fatalf("got %d stores, expected 4: %#v", len(doc.SecretsStores), doc.SecretsStores)
+	}
+
+	if err := deleteSecretsStore(data[1], client); err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]bool)
+	for _, s := range doc.SecretsStores {
+		got[s.Name] = true
+	}
+	if len(got) != 3 || !got["manual"] || !got["vault-a"] || !got["vault-c"] {
+		t.Errorf("got stores %v after deleting vault-b", got)
+	}
+
+	if err := readSecretsStore(data[1], client); err != nil {
+		t.Fatal(err)
+	}
+	if data[1].Id() != "" {
+		t.Errorf("deleted store still has ID %q", data[1].Id())
+	}
+}
@@ -0,0 +1,170 @@
+package prismacloudcompute
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/user"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceServiceUser() *schema.Resource {
+	return &schema.Resource{
+		Create: createServiceUser,
+		Read:   readServiceUser,
+		Update: updateServiceUser,
+		Delete: deleteServiceUser,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name of the user, used as the ID of its access keys.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     user.RoleCi,
+				Description: "Role of the user. Can be set to 'admin', 'operator', 'auditor', 'devSecOps', 'vulnerabilityManager', 'defenderManager', 'devOps', 'ci', or 'user'.",
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						user.RoleAdmin,
+						user.RoleOperator,
+						user.RoleAuditor,
+						user.RoleDevSecOps,
+						user.RoleVulnerabilityManager,
+						user.RoleDefenderManager,
+						user.RoleDevOps,
+						user.RoleCi,
+						user.RoleUser,
+					},
+					false,
+				),
+			},
+			"collections": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Names of the collections the user can see. Leave empty to allow all of them.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// newUserSecret returns a random password for a user.
+func newUserSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func parseServiceUser(d *schema.ResourceData) user.User {
+	ans := user.User{
+		Username: d.Get("username").(string),
+		Role:     d.Get("role").(string),
+		AuthType: user.AuthTypeBasic,
+	}
+	if collections := ListToStringSlice(d.Get("collections").([]interface{})); len(collections) > 0 {
+		ans.Permissions = []user.Permission{{Collections: collections}}
+	}
+
+	return ans
+}
+
+func saveServiceUser(d *schema.ResourceData, obj user.User) {
+	d.Set("username", obj.Username)
+	d.Set("role", obj.Role)
+
+	var collections []string
+	for _, perm := range obj.Permissions {
+		collections = append(collections, perm.Collections...)
+	}
+	d.Set("collections", collections)
+}
+
+func createServiceUser(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseServiceUser(d)
+
+	// The Console requires a password, but the user only ever logs in
+	// with the secrets of its access keys, so this one is never exposed.
+	password, err := newUserSecret()
+	if err != nil {
+		return err
+	}
+	obj.Password = password
+
+	if err := user.Create(client, obj); err != nil {
+		return err
+	}
+
+	PollApiUntilSuccess(func() error {
+		_, err := user.Get(client, obj.Username)
+		return err
+	})
+
+	d.SetId(obj.Username)
+	return readServiceUser(d, meta)
+}
+
+func readServiceUser(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := user.Get(client, d.Id())
+	if err != nil {
+		if err == pc.ObjectNotFoundError {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	saveServiceUser(d, obj)
+
+	return nil
+}
+
+func updateServiceUser(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseServiceUser(d)
+
+	if err := user.Update(client, obj); err != nil {
+		return err
+	}
+
+	return readServiceUser(d, meta)
+}
+
+func deleteServiceUser(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := user.Delete(client, d.Id()); err != nil {
+		if err != pc.ObjectNotFoundError {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,42 @@
+package prismacloudcompute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/user"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestParseServiceUser(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceServiceUser().Schema, map[string]interface{}{
+		"username":    "pipeline",
+		"collections": []interface{}{"payments", "checkout"},
+	})
+
+	obj := parseServiceUser(d)
+	expected := user.User{
+		Username:    "pipeline",
+		Role:        user.RoleCi,
+		AuthType:    user.AuthTypeBasic,
+		Permissions: []user.Permission{{Collections: []string{"payments", "checkout"}}},
+	}
+	if !reflect.DeepEqual(obj, expected) {
+		t.Errorf("got %#v, expected %#v", obj, expected)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceServiceUser().Schema, map[string]interface{}{})
+	saveServiceUser(d, obj)
+	if again := parseServiceUser(d); !reflect.DeepEqual(again, expected) {
+		t.Errorf("got %#v after a round trip", again)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceServiceUser().Schema, map[string]interface{}{
+		"username": "scanner",
+		"role":     user.RoleAuditor,
+	})
+	if obj := parseServiceUser(d); obj.Role != user.RoleAuditor || obj.Permissions != nil {
+		t.Errorf("user without collections is %#v", obj)
+	}
+}
@@ -0,0 +1,156 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsCodeRepos() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsCodeRepos,
+		Read:   readSettingsCodeRepos,
+		Update: updateSettingsCodeRepos,
+		Delete: deleteSettingsCodeRepos,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"specification": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifications of the code repositories to scan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Type of the repositories. Can be set to 'github', 'gitlab', or 'bitbucket'.",
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									settings.CodeRepoTypeGithub,
+									settings.CodeRepoTypeGitlab,
+									settings.CodeRepoTypeBitbucket,
+								},
+								false,
+							),
+						},
+						"credential_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the credential, in the Console's credentials store, used to access the repositories.",
+						},
+						"repositories": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Repositories to scan, as 'owner/name'. Wildcards are supported, such as 'example-org/*'.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"excluded_paths": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Paths in the repositories whose manifests are not scanned, such as 'test/*'.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"manifests": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Names of the manifests to scan, such as 'requirements.txt'. All supported manifests are scanned if not set.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func parseSettingsCodeRepos(d *schema.ResourceData) settings.CodeRepos {
+	obj := settings.CodeRepos{
+		Specifications: []settings.CodeRepoSpec{},
+	}
+
+	for _, item := range d.Get("specification").([]interface{}) {
+		x := item.(map[string]interface{})
+		obj.Specifications = append(obj.Specifications, settings.CodeRepoSpec{
+			Type:                  x["type"].(string),
+			CredentialId:          x["credential_id"].(string),
+			Repositories:          ListToStringSlice(x["repositories"].([]interface{})),
+			ExcludedManifestPaths: ListToStringSlice(x["excluded_paths"].([]interface{})),
+			ExplicitManifestNames: ListToStringSlice(x["manifests"].([]interface{})),
+		})
+	}
+
+	return obj
+}
+
+func saveSettingsCodeRepos(d *schema.ResourceData, obj settings.CodeRepos) {
+	specs := make([]interface{}, 0, len(obj.Specifications))
+	for _, x := range obj.Specifications {
+		specs = append(specs, map[string]interface{}{
+			"type":           x.Type,
+			"credential_id":  x.CredentialId,
+			"repositories":   x.Repositories,
+			"excluded_paths": x.ExcludedManifestPaths,
+			"manifests":      x.ExplicitManifestNames,
+		})
+	}
+
+	d.Set("specification", specs)
+}
+
+func readSettingsCodeRepos(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetCodeRepos(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.CodeReposName)
+	saveSettingsCodeRepos(d, obj)
+
+	return nil
+}
+
+func updateSettingsCodeRepos(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsCodeRepos(d)
+
+	if err := settings.UpdateCodeRepos(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.CodeReposName)
+	return readSettingsCodeRepos(d, meta)
+}
+
+func deleteSettingsCodeRepos(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateCodeRepos(client, settings.DefaultCodeRepos); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,46 @@
+package prismacloudcompute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestParseSettingsCodeRepos(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSettingsCodeRepos().Schema, map[string]interface{}{
+		"specification": []interface{}{
+			map[string]interface{}{
+				"type":           "github",
+				"credential_id":  "github-app",
+				"repositories":   []interface{}{"example-org/*", "example/tools"},
+				"excluded_paths": []interface{}{"test/*"},
+				"manifests":      []interface{}{"go.sum"},
+			},
+		},
+	})
+
+	obj := parseSettingsCodeRepos(d)
+	expected := settings.CodeRepos{
+		Specifications: []settings.CodeRepoSpec{
+			{
+				Type:                  settings.CodeRepoTypeGithub,
+				CredentialId:          "github-app",
+				Repositories:          []string{"example-org/*", "example/tools"},
+				ExcludedManifestPaths: []string{"test/*"},
+				ExplicitManifestNames: []string{"go.sum"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(obj, expected) {
+		t.Errorf("got %#v, expected %#v", obj, expected)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSettingsCodeRepos().Schema, map[string]interface{}{})
+	saveSettingsCodeRepos(d, obj)
+	if again := parseSettingsCodeRepos(d); !reflect.DeepEqual(again, expected) {
+		t.Errorf("got %#v after a round trip", again)
+	}
+}
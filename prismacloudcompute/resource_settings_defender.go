@@ -0,0 +1,121 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsDefender() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsDefender,
+		Read:   readSettingsDefender,
+		Update: updateSettingsDefender,
+		Delete: deleteSettingsDefender,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"listening_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      settings.DefaultDefender.ListeningPort,
+				Description:  "Port Defenders listen on for connections from the Console.",
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"admission_control_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     settings.DefaultDefender.AdmissionControlEnabled,
+				Description: "Have Defenders act as a Kubernetes admission controller, enforcing admission rules.",
+			},
+			"log_level": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     settings.DefaultDefender.LogLevel,
+				Description: "Log level of Defenders. Can be set to 'error', 'warning', 'info', or 'debug'.",
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						settings.LogLevelError,
+						settings.LogLevelWarning,
+						settings.LogLevelInfo,
+						settings.LogLevelDebug,
+					},
+					false,
+				),
+			},
+			"disconnect_period_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      settings.DefaultDefender.DisconnectPeriodDays,
+				Description:  "Days after which Defenders that stopped reporting to the Console are removed.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func parseSettingsDefender(d *schema.ResourceData) settings.Defender {
+	return settings.Defender{
+		ListeningPort:           d.Get("listening_port").(int),
+		AdmissionControlEnabled: d.Get("admission_control_enabled").(bool),
+		LogLevel:                d.Get("log_level").(string),
+		DisconnectPeriodDays:    d.Get("disconnect_period_days").(int),
+	}
+}
+
+func saveSettingsDefender(d *schema.ResourceData, obj settings.Defender) {
+	d.Set("listening_port", obj.ListeningPort)
+	d.Set("admission_control_enabled", obj.AdmissionControlEnabled)
+	d.Set("log_level", obj.LogLevel)
+	d.Set("disconnect_period_days", obj.DisconnectPeriodDays)
+}
+
+func readSettingsDefender(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetDefender(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.DefenderName)
+	saveSettingsDefender(d, obj)
+
+	return nil
+}
+
+func updateSettingsDefender(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsDefender(d)
+
+	if err := settings.UpdateDefender(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.DefenderName)
+	return readSettingsDefender(d, meta)
+}
+
+func deleteSettingsDefender(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateDefender(client, settings.DefaultDefender); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
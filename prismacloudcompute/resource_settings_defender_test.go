@@ -0,0 +1,85 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSettingsDefenderConfig(t *testing.T) {
+	var o settings.Defender
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSettingsDefenderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsDefenderConfig(9997),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsDefenderExists("prismacloudcompute_settings_defender.test", &o),
+					testAccCheckSettingsDefenderAttributes(&o, 9997),
+				),
+			},
+			{
+				Config: testAccSettingsDefenderConfig(9999),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsDefenderExists("prismacloudcompute_settings_defender.test", &o),
+					testAccCheckSettingsDefenderAttributes(&o, 9999),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingsDefenderExists(n string, o *settings.Defender) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := settings.GetDefender(client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckSettingsDefenderAttributes(o *settings.Defender, v int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.ListeningPort != v {
+			return fmt.Errorf("Listening port is %d, expected %d", o.ListeningPort, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccSettingsDefenderDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	o, err := settings.GetDefender(client)
+	if err != nil {
+		return fmt.Errorf("Error in get: %s", err)
+	}
+	if o != settings.DefaultDefender {
+		return fmt.Errorf("Defender settings were not restored: %#v", o)
+	}
+
+	return nil
+}
+
+func testAccSettingsDefenderConfig(v int) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_settings_defender" "test" {
+    listening_port = %d
+}`, v)
+}
@@ -0,0 +1,121 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsForensic() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsForensic,
+		Read:   readSettingsForensic,
+		Update: updateSettingsForensic,
+		Delete: deleteSettingsForensic,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     settings.DefaultForensic.Enabled,
+				Description: "Collect forensic data on containers and hosts.",
+			},
+			"container_disk_usage_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      settings.DefaultForensic.ContainerDiskUsageMb,
+				Description:  "Disk space, in MB, used to keep the forensic data of each container.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"host_disk_usage_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      settings.DefaultForensic.HostDiskUsageMb,
+				Description:  "Disk space, in MB, used to keep the forensic data of each host.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"incident_snapshots_cap": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      settings.DefaultForensic.IncidentSnapshotsCap,
+				Description:  "Maximum number of incident snapshots the Console keeps.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"collect_network_snapshot": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     settings.DefaultForensic.CollectNetworkSnapshot,
+				Description: "Collect the network connections of containers and hosts when an incident occurs.",
+			},
+		},
+	}
+}
+
+func parseSettingsForensic(d *schema.ResourceData) settings.Forensic {
+	return settings.Forensic{
+		Enabled:                d.Get("enabled").(bool),
+		ContainerDiskUsageMb:   d.Get("container_disk_usage_mb").(int),
+		HostDiskUsageMb:        d.Get("host_disk_usage_mb").(int),
+		IncidentSnapshotsCap:   d.Get("incident_snapshots_cap").(int),
+		CollectNetworkSnapshot: d.Get("collect_network_snapshot").(bool),
+	}
+}
+
+func saveSettingsForensic(d *schema.ResourceData, obj settings.Forensic) {
+	d.Set("enabled", obj.Enabled)
+	d.Set("container_disk_usage_mb", obj.ContainerDiskUsageMb)
+	d.Set("host_disk_usage_mb", obj.HostDiskUsageMb)
+	d.Set("incident_snapshots_cap", obj.IncidentSnapshotsCap)
+	d.Set("collect_network_snapshot", obj.CollectNetworkSnapshot)
+}
+
+func readSettingsForensic(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetForensic(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.ForensicName)
+	saveSettingsForensic(d, obj)
+
+	return nil
+}
+
+func updateSettingsForensic(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsForensic(d)
+
+	if err := settings.UpdateForensic(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.ForensicName)
+	return readSettingsForensic(d, meta)
+}
+
+func deleteSettingsForensic(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateForensic(client, settings.DefaultForensic); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
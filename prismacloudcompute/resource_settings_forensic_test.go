@@ -0,0 +1,85 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSettingsForensicConfig(t *testing.T) {
+	var o settings.Forensic
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSettingsForensicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsForensicConfig(500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsForensicExists("prismacloudcompute_settings_forensic.test", &o),
+					testAccCheckSettingsForensicAttributes(&o, 500),
+				),
+			},
+			{
+				Config: testAccSettingsForensicConfig(2000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsForensicExists("prismacloudcompute_settings_forensic.test", &o),
+					testAccCheckSettingsForensicAttributes(&o, 2000),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingsForensicExists(n string, o *settings.Forensic) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := settings.GetForensic(client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckSettingsForensicAttributes(o *settings.Forensic, v int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.HostDiskUsageMb != v {
+			return fmt.Errorf("Host disk usage is %d, expected %d", o.HostDiskUsageMb, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccSettingsForensicDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	o, err := settings.GetForensic(client)
+	if err != nil {
+		return fmt.Errorf("Error in get: %s", err)
+	}
+	if o != settings.DefaultForensic {
+		return fmt.Errorf("Forensic settings were not restored: %#v", o)
+	}
+
+	return nil
+}
+
+func testAccSettingsForensicConfig(v int) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_settings_forensic" "test" {
+    host_disk_usage_mb = %d
+}`, v)
+}
@@ -0,0 +1,145 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsLdap() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsLdap,
+		Read:   readSettingsLdap,
+		Update: updateSettingsLdap,
+		Delete: deleteSettingsLdap,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether users can log in with their LDAP credentials.",
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "URL of the LDAP server, such as 'ldaps://ldap.example.com:636'.",
+				ValidateFunc: validation.IsURLWithScheme([]string{"ldap", "ldaps"}),
+			},
+			"search_base": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Base DN to search for users in.",
+			},
+			"user_search_identifier": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     settings.DefaultLdap.UserSearchIdentifier,
+				Description: "Attribute that holds the username.",
+			},
+			"group_search_base": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base DN to search for groups in, if different from the search base.",
+			},
+			"account_upn": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Log users in with their user principal name (Active Directory).",
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DN of the service account that searches the directory.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the service account.",
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded CA certificate of the LDAP server.",
+			},
+		},
+	}
+}
+
+func parseSettingsLdap(d *schema.ResourceData) settings.Ldap {
+	return settings.Ldap{
+		Enabled:              d.Get("enabled").(bool),
+		Url:                  d.Get("url").(string),
+		SearchBase:           d.Get("search_base").(string),
+		UserSearchIdentifier: d.Get("user_search_identifier").(string),
+		GroupSearchBase:      d.Get("group_search_base").(string),
+		AccountUpn:           d.Get("account_upn").(bool),
+		User:                 d.Get("user").(string),
+		Password:             settingsSecret(d, "password"),
+		CaCert:               d.Get("ca_cert").(string),
+	}
+}
+
+func saveSettingsLdap(d *schema.ResourceData, obj settings.Ldap) {
+	d.Set("enabled", obj.Enabled)
+	d.Set("url", obj.Url)
+	d.Set("search_base", obj.SearchBase)
+	d.Set("user_search_identifier", obj.UserSearchIdentifier)
+	d.Set("group_search_base", obj.GroupSearchBase)
+	d.Set("account_upn", obj.AccountUpn)
+	d.Set("user", obj.User)
+	d.Set("ca_cert", obj.CaCert)
+}
+
+func readSettingsLdap(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetLdap(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.LdapName)
+	saveSettingsLdap(d, obj)
+
+	return nil
+}
+
+func updateSettingsLdap(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsLdap(d)
+
+	if err := settings.UpdateLdap(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.LdapName)
+	return readSettingsLdap(d, meta)
+}
+
+func deleteSettingsLdap(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateLdap(client, settings.DefaultLdap); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
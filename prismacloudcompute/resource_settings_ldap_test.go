@@ -0,0 +1,92 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSettingsLdapConfig(t *testing.T) {
+	var o settings.Ldap
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSettingsLdapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsLdapConfig("dc=example,dc=com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsLdapExists("prismacloudcompute_settings_ldap.test", &o),
+					testAccCheckSettingsLdapAttributes(&o, "dc=example,dc=com"),
+				),
+			},
+			{
+				Config: testAccSettingsLdapConfig("ou=people,dc=example,dc=com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsLdapExists("prismacloudcompute_settings_ldap.test", &o),
+					testAccCheckSettingsLdapAttributes(&o, "ou=people,dc=example,dc=com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingsLdapExists(n string, o *settings.Ldap) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := settings.GetLdap(client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckSettingsLdapAttributes(o *settings.Ldap, searchBase string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !o.Enabled {
+			return fmt.Errorf("LDAP is not enabled")
+		}
+
+		if o.SearchBase != searchBase {
+			return fmt.Errorf("Search base is %s, expected %s", o.SearchBase, searchBase)
+		}
+
+		return nil
+	}
+}
+
+func testAccSettingsLdapDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	o, err := settings.GetLdap(client)
+	if err != nil {
+		return fmt.Errorf("Error in get: %s", err)
+	}
+	if o.Enabled {
+		return fmt.Errorf("LDAP is still enabled")
+	}
+
+	return nil
+}
+
+func testAccSettingsLdapConfig(searchBase string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_settings_ldap" "test" {
+    url = "ldaps://ldap.example.com:636"
+    search_base = %q
+    user = "cn=twistlock,dc=example,dc=com"
+    password = "correct horse battery staple"
+}`, searchBase)
+}
@@ -0,0 +1,245 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsLogging() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsLogging,
+		Read:   readSettingsLogging,
+		Update: updateSettingsLogging,
+		Delete: deleteSettingsLogging,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"audit_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      settings.DefaultLogging.AuditRetentionDays,
+				Description:  "Days the Console keeps audit events.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"syslog": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Forward events to a syslog server.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "URI of the syslog server, such as 'udp://siem.example.com:514'. The scheme can be 'udp' or 'tcp'.",
+							ValidateFunc: validateSyslogAddress,
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     settings.SyslogProtocolRfc5424,
+							Description: "Message format. Can be set to 'rfc3164' or 'rfc5424'.",
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									settings.SyslogProtocolRfc3164,
+									settings.SyslogProtocolRfc5424,
+								},
+								false,
+							),
+						},
+						"identifier": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Identifier added to every message, to tell Consoles apart.",
+						},
+						"verbose": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Forward detailed events, including process and network activity.",
+						},
+					},
+				},
+			},
+			"stdout": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Write events to the standard output of the Console and Defenders.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"verbose": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Write detailed events, including process and network activity.",
+						},
+					},
+				},
+			},
+			"prometheus_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     settings.DefaultLogging.EnableMetricsCollection,
+				Description: "Expose metrics for Prometheus at the Console's /api/v1/metrics endpoint.",
+			},
+			"audit_categories": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Categories of audit events to log. All categories are logged if not set.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(settings.AuditCategories, false),
+				},
+			},
+		},
+	}
+}
+
+// validateSyslogAddress accepts URIs of the form <udp|tcp>://host:port.
+func validateSyslogAddress(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	u, err := url.Parse(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q is not a valid URI: %s", k, err)}
+	}
+	if u.Scheme != "udp" && u.Scheme != "tcp" {
+		return nil, []error{fmt.Errorf("%q must use the udp or tcp scheme, got %q", k, v)}
+	}
+	if u.Hostname() == "" {
+		return nil, []error{fmt.Errorf("%q must have a host, got %q", k, v)}
+	}
+	if port, err := strconv.Atoi(u.Port()); err != nil || port < 1 || port > 65535 {
+		return nil, []error{fmt.Errorf("%q must have a port between 1 and 65535, got %q", k, v)}
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.User != nil {
+		return nil, []error{fmt.Errorf("%q must only have a scheme, host and port, got %q", k, v)}
+	}
+
+	return nil, nil
+}
+
+func parseSettingsLogging(d *schema.ResourceData) settings.Logging {
+	obj := settings.Logging{
+		AuditRetentionDays:      d.Get("audit_retention_days").(int),
+		EnableMetricsCollection: d.Get("prometheus_enabled").(bool),
+		AuditCategories:         SetToStringSlice(d.Get("audit_categories").(*schema.Set)),
+	}
+
+	if len(obj.AuditCategories) == 0 {
+		obj.AuditCategories = settings.AuditCategories
+	}
+
+	if v := d.Get("syslog").([]interface{}); len(v) != 0 && v[0] != nil {
+		x := v[0].(map[string]interface{})
+		obj.Syslog = settings.Syslog{
+			Enabled:    true,
+			Addr:       x["address"].(string),
+			Protocol:   x["protocol"].(string),
+			Identifier: x["identifier"].(string),
+			Verbose:    x["verbose"].(bool),
+		}
+	}
+
+	if v := d.Get("stdout").([]interface{}); len(v) != 0 {
+		obj.Stdout.Enabled = true
+		if v[0] != nil {
+			obj.Stdout.Verbose = v[0].(map[string]interface{})["verbose"].(bool)
+		}
+	}
+
+	return obj
+}
+
+func saveSettingsLogging(d *schema.ResourceData, obj settings.Logging) {
+	d.Set("audit_retention_days", obj.AuditRetentionDays)
+	d.Set("prometheus_enabled", obj.EnableMetricsCollection)
+
+	// The Console lists every category when all are logged, which is kept
+	// unset while audit_categories is not configured.
+	categories := StringSliceToSet(obj.AuditCategories)
+	all := StringSliceToSet(settings.AuditCategories)
+	if d.Get("audit_categories").(*schema.Set).Len() == 0 && categories.Len() == all.Len() && categories.Difference(all).Len() == 0 {
+		categories = schema.NewSet(schema.HashString, nil)
+	}
+	d.Set("audit_categories", categories)
+
+	var syslog []interface{}
+	if obj.Syslog.Enabled {
+		syslog = []interface{}{
+			map[string]interface{}{
+				"address":    obj.Syslog.Addr,
+				"protocol":   obj.Syslog.Protocol,
+				"identifier": obj.Syslog.Identifier,
+				"verbose":    obj.Syslog.Verbose,
+			},
+		}
+	}
+	d.Set("syslog", syslog)
+
+	var stdout []interface{}
+	if obj.Stdout.Enabled {
+		stdout = []interface{}{
+			map[string]interface{}{
+				"verbose": obj.Stdout.Verbose,
+			},
+		}
+	}
+	d.Set("stdout", stdout)
+}
+
+func readSettingsLogging(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetLogging(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.LoggingName)
+	saveSettingsLogging(d, obj)
+
+	return nil
+}
+
+func updateSettingsLogging(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsLogging(d)
+
+	if err := settings.UpdateLogging(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.LoggingName)
+	return readSettingsLogging(d, meta)
+}
+
+func deleteSettingsLogging(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateLogging(client, settings.DefaultLogging); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
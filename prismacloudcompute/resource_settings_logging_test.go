@@ -0,0 +1,249 @@
+package prismacloudcompute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSettingsLoggingConfig(t *testing.T) {
+	var o settings.Logging
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSettingsLoggingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsLoggingConfig(7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsLoggingExists("prismacloudcompute_settings_logging.test", &o),
+					testAccCheckSettingsLoggingAttributes(&o, 7),
+				),
+			},
+			{
+				Config: testAccSettingsLoggingConfig(90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsLoggingExists("prismacloudcompute_settings_logging.test", &o),
+					testAccCheckSettingsLoggingAttributes(&o, 90),
+				),
+			},
+		},
+	})
+}
+
+func TestSettingsLoggingKeepsUnmanagedFields(t *testing.T) {
+	doc := map[string]interface{}{
+		"auditRetentionDays": 30,
+		"syslog": map[string]interface{}{
+			"enabled": true,
+			"addr":    "tcp://syslog.example.com:514",
+		},
+		"includeRuntimeLink": true,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/authenticate":
+			fmt.Fprint(w, `{"token": "opaque"}`)
+		case r.URL.Path == "/api/v1/settings/logging" && r.Method == "GET":
+			json.NewEncoder(w).Encode(doc)
+		case r.URL.Path == "/api/v1/settings/logging" && r.Method == "POST":
+			doc = nil
+			json.NewDecoder(r.Body).Decode(&doc)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := newApiClient(&pc.Client{
+		Url:      strings.TrimPrefix(srv.URL, "http://"),
+		Protocol: "http",
+		Username: "admin",
+		Password: "admin",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSettingsLogging().Schema, map[string]interface{}{
+		"audit_retention_days": 90,
+	})
+	if err := updateSettingsLogging(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if doc["auditRetentionDays"] != float64(90) {
+		t.Errorf("audit retention is %v, expected 90", doc["auditRetentionDays"])
+	}
+	if syslog, _ := doc["syslog"].(map[string]interface{}); syslog["enabled"] != false {
+		t.Errorf("syslog is still enabled without a syslog block: %v", doc["syslog"])
+	}
+	if doc["includeRuntimeLink"] != true {
+		t.Errorf("update dropped a field the resource does not manage: %v", doc)
+	}
+
+	doc["syslog"] = map[string]interface{}{
+		"enabled":    true,
+		"addr":       "tcp://syslog.example.com:514",
+		"identifier": "console",
+	}
+	d = schema.TestResourceDataRaw(t, resourceSettingsLogging().Schema, map[string]interface{}{
+		"syslog": []interface{}{
+			map[string]interface{}{"address": "udp://siem.example.com:514"},
+		},
+	})
+	if err := updateSettingsLogging(d, client); err != nil {
+		t.Fatal(err)
+	}
+	syslog, _ := doc["syslog"].(map[string]interface{})
+	if syslog["addr"] != "udp://siem.example.com:514" || syslog["identifier"] != "" {
+		t.Errorf("syslog is %v, expected the new address and no identifier", syslog)
+	}
+}
+
+func testAccCheckSettingsLoggingExists(n string, o *settings.Logging) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := settings.GetLogging(client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckSettingsLoggingAttributes(o *settings.Logging, v int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.AuditRetentionDays != v {
+			return fmt.Errorf("Audit retention is %d, expected %d", o.AuditRetentionDays, v)
+		}
+
+		if !o.Syslog.Enabled || o.Syslog.Addr != "udp://siem.example.com:514" {
+			return fmt.Errorf("Syslog is %#v, expected forwarding to udp://siem.example.com:514", o.Syslog)
+		}
+
+		return nil
+	}
+}
+
+func testAccSettingsLoggingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	o, err := settings.GetLogging(client)
+	if err != nil {
+		return fmt.Errorf("Error in get: %s", err)
+	}
+	if !reflect.DeepEqual(o, settings.DefaultLogging) {
+		return fmt.Errorf("Logging settings were not restored: %#v", o)
+	}
+
+	return nil
+}
+
+func testAccSettingsLoggingConfig(v int) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_settings_logging" "test" {
+    audit_retention_days = %d
+    syslog {
+        address = "udp://siem.example.com:514"
+        identifier = "terraform-acc"
+    }
+}`, v)
+}
+
+func TestValidateSyslogAddress(t *testing.T) {
+	valid := []string{
+		"udp://siem.example.com:514",
+		"tcp://10.0.0.1:6514",
+		"tcp://[2001:db8::1]:514",
+	}
+	invalid := []string{
+		"siem.example.com:514",
+		"http://siem.example.com:514",
+		"udp://siem.example.com",
+		"udp://siem.example.com:0",
+		"udp://:514",
+		"tcp://siem.example.com:514/path",
+		"tcp://user@siem.example.com:514",
+	}
+
+	for _, v := range valid {
+		if _, errs := validateSyslogAddress(v, "address"); len(errs) != 0 {
+			t.Errorf("%s: unexpected errors: %v", v, errs)
+		}
+	}
+	for _, v := range invalid {
+		if _, errs := validateSyslogAddress(v, "address"); len(errs) == 0 {
+			t.Errorf("%s: expected an error", v)
+		}
+	}
+}
+
+func TestParseSettingsLogging(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSettingsLogging().Schema, map[string]interface{}{
+		"syslog": []interface{}{
+			map[string]interface{}{
+				"address": "tcp://siem.example.com:514",
+				"verbose": true,
+			},
+		},
+		"stdout":           []interface{}{nil},
+		"audit_categories": []interface{}{"access", "runtime"},
+	})
+
+	obj := parseSettingsLogging(d)
+	if !obj.Syslog.Enabled || obj.Syslog.Addr != "tcp://siem.example.com:514" || !obj.Syslog.Verbose {
+		t.Errorf("syslog is %#v", obj.Syslog)
+	}
+	if obj.Syslog.Protocol != settings.SyslogProtocolRfc5424 {
+		t.Errorf("syslog protocol is %q, expected the default", obj.Syslog.Protocol)
+	}
+	if !obj.Stdout.Enabled || obj.Stdout.Verbose {
+		t.Errorf("stdout is %#v", obj.Stdout)
+	}
+	if len(obj.AuditCategories) != 2 {
+		t.Errorf("audit categories are %v", obj.AuditCategories)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSettingsLogging().Schema, map[string]interface{}{})
+	obj = parseSettingsLogging(d)
+	if obj.Syslog.Enabled || obj.Stdout.Enabled {
+		t.Errorf("forwarding enabled without configuration: %#v", obj)
+	}
+	if !reflect.DeepEqual(obj.AuditCategories, settings.AuditCategories) {
+		t.Errorf("audit categories are %v, expected all", obj.AuditCategories)
+	}
+
+	// Logging all categories reads back as unset, unless they were listed.
+	saveSettingsLogging(d, obj)
+	if got := d.Get("audit_categories").(*schema.Set).Len(); got != 0 {
+		t.Errorf("%d audit categories saved while not configured", got)
+	}
+	all := make([]interface{}, len(settings.AuditCategories))
+	for i, v := range settings.AuditCategories {
+		all[i] = v
+	}
+	d = schema.TestResourceDataRaw(t, resourceSettingsLogging().Schema, map[string]interface{}{"audit_categories": all})
+	saveSettingsLogging(d, obj)
+	if got := d.Get("audit_categories").(*schema.Set).Len(); got != len(all) {
+		t.Errorf("%d audit categories saved, expected the %d listed", got, len(all))
+	}
+}
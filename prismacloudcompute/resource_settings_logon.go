@@ -0,0 +1,108 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsLogon() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsLogon,
+		Read:   readSettingsLogon,
+		Update: updateSettingsLogon,
+		Delete: deleteSettingsLogon,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"session_timeout_sec": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      settings.DefaultLogon.SessionTimeoutSec,
+				Description:  "Seconds of inactivity after which users are logged out of the Console.",
+				ValidateFunc: validation.IntAtLeast(60),
+			},
+			"basic_auth_disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Disable logging in with a username and password, so users must log in through an identity provider.",
+			},
+			"strong_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Require strong passwords for local users.",
+			},
+			"use_support_credentials": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Allow Palo Alto Networks support to log in with the support credentials.",
+			},
+		},
+	}
+}
+
+func parseSettingsLogon(d *schema.ResourceData) settings.Logon {
+	return settings.Logon{
+		SessionTimeoutSec:     d.Get("session_timeout_sec").(int),
+		BasicAuthDisabled:     d.Get("basic_auth_disabled").(bool),
+		StrongPassword:        d.Get("strong_password").(bool),
+		UseSupportCredentials: d.Get("use_support_credentials").(bool),
+	}
+}
+
+func saveSettingsLogon(d *schema.ResourceData, obj settings.Logon) {
+	d.Set("session_timeout_sec", obj.SessionTimeoutSec)
+	d.Set("basic_auth_disabled", obj.BasicAuthDisabled)
+	d.Set("strong_password", obj.StrongPassword)
+	d.Set("use_support_credentials", obj.UseSupportCredentials)
+}
+
+func readSettingsLogon(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetLogon(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.LogonName)
+	saveSettingsLogon(d, obj)
+
+	return nil
+}
+
+func updateSettingsLogon(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsLogon(d)
+
+	if err := settings.UpdateLogon(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.LogonName)
+	return readSettingsLogon(d, meta)
+}
+
+func deleteSettingsLogon(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateLogon(client, settings.DefaultLogon); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,90 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSettingsLogonConfig(t *testing.T) {
+	var o settings.Logon
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSettingsLogonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsLogonConfig(3600, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsLogonExists("prismacloudcompute_settings_logon.test", &o),
+					testAccCheckSettingsLogonAttributes(&o, 3600, true),
+				),
+			},
+			{
+				Config: testAccSettingsLogonConfig(900, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsLogonExists("prismacloudcompute_settings_logon.test", &o),
+					testAccCheckSettingsLogonAttributes(&o, 900, false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingsLogonExists(n string, o *settings.Logon) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := settings.GetLogon(client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckSettingsLogonAttributes(o *settings.Logon, timeout int, strong bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.SessionTimeoutSec != timeout {
+			return fmt.Errorf("Session timeout is %d, expected %d", o.SessionTimeoutSec, timeout)
+		}
+
+		if o.StrongPassword != strong {
+			return fmt.Errorf("Strong password is %t, expected %t", o.StrongPassword, strong)
+		}
+
+		return nil
+	}
+}
+
+func testAccSettingsLogonDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	o, err := settings.GetLogon(client)
+	if err != nil {
+		return fmt.Errorf("Error in get: %s", err)
+	}
+	if o != settings.DefaultLogon {
+		return fmt.Errorf("Logon settings were not restored: %#v", o)
+	}
+
+	return nil
+}
+
+func testAccSettingsLogonConfig(timeout int, strong bool) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_settings_logon" "test" {
+    session_timeout_sec = %d
+    strong_password = %t
+}`, timeout, strong)
+}
@@ -0,0 +1,159 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsOauth() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsOauth,
+		Read:   readSettingsOauth,
+		Update: updateSettingsOauth,
+		Delete: deleteSettingsOauth,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether users can log in through the OAuth 2.0 provider.",
+			},
+			"identity_provider": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Identity provider. Can be set to 'github' or 'openshift'.",
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						settings.OauthProviderGithub,
+						settings.OauthProviderOpenshift,
+					},
+					false,
+				),
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Client ID of the Console, as registered with the identity provider.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Client secret of the Console.",
+			},
+			"auth_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Authorization endpoint of the identity provider.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"token_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Token endpoint of the identity provider.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"user_claim": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Claim that holds the username.",
+			},
+			"group_scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Scope to request to get the user's groups.",
+			},
+			"group_claim": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Claim that holds the user's groups.",
+			},
+			"cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded CA certificate of the identity provider.",
+			},
+		},
+	}
+}
+
+func parseSettingsOauth(d *schema.ResourceData) settings.Oauth {
+	return settings.Oauth{
+		Enabled:      d.Get("enabled").(bool),
+		Provider:     d.Get("identity_provider").(string),
+		ClientId:     d.Get("client_id").(string),
+		ClientSecret: settingsSecret(d, "client_secret"),
+		AuthUrl:      d.Get("auth_url").(string),
+		TokenUrl:     d.Get("token_url").(string),
+		UserClaim:    d.Get("user_claim").(string),
+		GroupScope:   d.Get("group_scope").(string),
+		GroupClaim:   d.Get("group_claim").(string),
+		Cert:         d.Get("cert").(string),
+	}
+}
+
+func saveSettingsOauth(d *schema.ResourceData, obj settings.Oauth) {
+	d.Set("enabled", obj.Enabled)
+	d.Set("identity_provider", obj.Provider)
+	d.Set("client_id", obj.ClientId)
+	d.Set("auth_url", obj.AuthUrl)
+	d.Set("token_url", obj.TokenUrl)
+	d.Set("user_claim", obj.UserClaim)
+	d.Set("group_scope", obj.GroupScope)
+	d.Set("group_claim", obj.GroupClaim)
+	d.Set("cert", obj.Cert)
+}
+
+func readSettingsOauth(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetOauth(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.OauthName)
+	saveSettingsOauth(d, obj)
+
+	return nil
+}
+
+func updateSettingsOauth(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsOauth(d)
+
+	if err := settings.UpdateOauth(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.OauthName)
+	return readSettingsOauth(d, meta)
+}
+
+func deleteSettingsOauth(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateOauth(client, settings.DefaultOauth); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
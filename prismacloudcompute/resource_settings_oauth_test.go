@@ -0,0 +1,93 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSettingsOauthConfig(t *testing.T) {
+	var o settings.Oauth
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSettingsOauthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsOauthConfig("console-a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsOauthExists("prismacloudcompute_settings_oauth.test", &o),
+					testAccCheckSettingsOauthAttributes(&o, "console-a"),
+				),
+			},
+			{
+				Config: testAccSettingsOauthConfig("console-b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsOauthExists("prismacloudcompute_settings_oauth.test", &o),
+					testAccCheckSettingsOauthAttributes(&o, "console-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingsOauthExists(n string, o *settings.Oauth) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := settings.GetOauth(client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckSettingsOauthAttributes(o *settings.Oauth, clientId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !o.Enabled {
+			return fmt.Errorf("OAuth is not enabled")
+		}
+
+		if o.ClientId != clientId {
+			return fmt.Errorf("Client ID is %s, expected %s", o.ClientId, clientId)
+		}
+
+		return nil
+	}
+}
+
+func testAccSettingsOauthDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	o, err := settings.GetOauth(client)
+	if err != nil {
+		return fmt.Errorf("Error in get: %s", err)
+	}
+	if o.Enabled {
+		return fmt.Errorf("OAuth is still enabled")
+	}
+
+	return nil
+}
+
+func testAccSettingsOauthConfig(clientId string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_settings_oauth" "test" {
+    identity_provider = "github"
+    client_id = %q
+    client_secret = "correct horse battery staple"
+    auth_url = "https://github.com/login/oauth/authorize"
+    token_url = "https://github.com/login/oauth/access_token"
+}`, clientId)
+}
@@ -0,0 +1,152 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsOidc() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsOidc,
+		Read:   readSettingsOidc,
+		Update: updateSettingsOidc,
+		Delete: deleteSettingsOidc,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether users can log in through the OpenID Connect provider.",
+			},
+			"provider_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the identity provider shown on the login page.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Client ID of the Console, as registered with the identity provider.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Client secret of the Console.",
+			},
+			"auth_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Authorization endpoint of the identity provider.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"token_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Token endpoint of the identity provider.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"user_claim": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Claim that holds the username.",
+			},
+			"group_scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Scope to request to get the user's groups.",
+			},
+			"group_claim": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Claim that holds the user's groups.",
+			},
+			"cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded CA certificate of the identity provider.",
+			},
+		},
+	}
+}
+
+func parseSettingsOidc(d *schema.ResourceData) settings.Oidc {
+	return settings.Oidc{
+		Enabled:       d.Get("enabled").(bool),
+		ProviderAlias: d.Get("provider_alias").(string),
+		ClientId:      d.Get("client_id").(string),
+		ClientSecret:  settingsSecret(d, "client_secret"),
+		AuthUrl:       d.Get("auth_url").(string),
+		TokenUrl:      d.Get("token_url").(string),
+		UserClaim:     d.Get("user_claim").(string),
+		GroupScope:    d.Get("group_scope").(string),
+		GroupClaim:    d.Get("group_claim").(string),
+		Cert:          d.Get("cert").(string),
+	}
+}
+
+func saveSettingsOidc(d *schema.ResourceData, obj settings.Oidc) {
+	d.Set("enabled", obj.Enabled)
+	d.Set("provider_alias", obj.ProviderAlias)
+	d.Set("client_id", obj.ClientId)
+	d.Set("auth_url", obj.AuthUrl)
+	d.Set("token_url", obj.TokenUrl)
+	d.Set("user_claim", obj.UserClaim)
+	d.Set("group_scope", obj.GroupScope)
+	d.Set("group_claim", obj.GroupClaim)
+	d.Set("cert", obj.Cert)
+}
+
+func readSettingsOidc(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetOidc(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.OidcName)
+	saveSettingsOidc(d, obj)
+
+	return nil
+}
+
+func updateSettingsOidc(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsOidc(d)
+
+	if err := settings.UpdateOidc(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.OidcName)
+	return readSettingsOidc(d, meta)
+}
+
+func deleteSettingsOidc(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateOidc(client, settings.DefaultOidc); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,93 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSettingsOidcConfig(t *testing.T) {
+	var o settings.Oidc
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSettingsOidcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsOidcConfig("console-a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsOidcExists("prismacloudcompute_settings_oidc.test", &o),
+					testAccCheckSettingsOidcAttributes(&o, "console-a"),
+				),
+			},
+			{
+				Config: testAccSettingsOidcConfig("console-b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsOidcExists("prismacloudcompute_settings_oidc.test", &o),
+					testAccCheckSettingsOidcAttributes(&o, "console-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingsOidcExists(n string, o *settings.Oidc) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := settings.GetOidc(client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckSettingsOidcAttributes(o *settings.Oidc, clientId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !o.Enabled {
+			return fmt.Errorf("OpenID Connect is not enabled")
+		}
+
+		if o.ClientId != clientId {
+			return fmt.Errorf("Client ID is %s, expected %s", o.ClientId, clientId)
+		}
+
+		return nil
+	}
+}
+
+func testAccSettingsOidcDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	o, err := settings.GetOidc(client)
+	if err != nil {
+		return fmt.Errorf("Error in get: %s", err)
+	}
+	if o.Enabled {
+		return fmt.Errorf("OpenID Connect is still enabled")
+	}
+
+	return nil
+}
+
+func testAccSettingsOidcConfig(clientId string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_settings_oidc" "test" {
+    provider_alias = "example"
+    client_id = %q
+    client_secret = "correct horse battery staple"
+    auth_url = "https://login.example.com/oauth2/v1/authorize"
+    token_url = "https://login.example.com/oauth2/v1/token"
+}`, clientId)
+}
@@ -0,0 +1,95 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsRuntime() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsRuntime,
+		Read:   readSettingsRuntime,
+		Update: updateSettingsRuntime,
+		Delete: deleteSettingsRuntime,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"automatic_learning": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     settings.DefaultRuntime.AutomaticLearning,
+				Description: "Learn runtime models of new images and hosts automatically.",
+			},
+			"learning_period_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      settings.DefaultRuntime.LearningPeriodHours,
+				Description:  "Hours a runtime model is learned for before it is enforced.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func parseSettingsRuntime(d *schema.ResourceData) settings.Runtime {
+	return settings.Runtime{
+		AutomaticLearning:   d.Get("automatic_learning").(bool),
+		LearningPeriodHours: d.Get("learning_period_hours").(int),
+	}
+}
+
+func saveSettingsRuntime(d *schema.ResourceData, obj settings.Runtime) {
+	d.Set("automatic_learning", obj.AutomaticLearning)
+	d.Set("learning_period_hours", obj.LearningPeriodHours)
+}
+
+func readSettingsRuntime(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetRuntime(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.RuntimeName)
+	saveSettingsRuntime(d, obj)
+
+	return nil
+}
+
+func updateSettingsRuntime(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsRuntime(d)
+
+	if err := settings.UpdateRuntime(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.RuntimeName)
+	return readSettingsRuntime(d, meta)
+}
+
+func deleteSettingsRuntime(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateRuntime(client, settings.DefaultRuntime); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
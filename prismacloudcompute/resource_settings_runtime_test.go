@@ -0,0 +1,85 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSettingsRuntimeConfig(t *testing.T) {
+	var o settings.Runtime
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSettingsRuntimeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsRuntimeConfig(12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsRuntimeExists("prismacloudcompute_settings_runtime.test", &o),
+					testAccCheckSettingsRuntimeAttributes(&o, 12),
+				),
+			},
+			{
+				Config: testAccSettingsRuntimeConfig(48),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsRuntimeExists("prismacloudcompute_settings_runtime.test", &o),
+					testAccCheckSettingsRuntimeAttributes(&o, 48),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingsRuntimeExists(n string, o *settings.Runtime) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := settings.GetRuntime(client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckSettingsRuntimeAttributes(o *settings.Runtime, v int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.LearningPeriodHours != v {
+			return fmt.Errorf("Learning period is %d, expected %d", o.LearningPeriodHours, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccSettingsRuntimeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	o, err := settings.GetRuntime(client)
+	if err != nil {
+		return fmt.Errorf("Error in get: %s", err)
+	}
+	if o != settings.DefaultRuntime {
+		return fmt.Errorf("Runtime settings were not restored: %#v", o)
+	}
+
+	return nil
+}
+
+func testAccSettingsRuntimeConfig(v int) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_settings_runtime" "test" {
+    learning_period_hours = %d
+}`, v)
+}
@@ -0,0 +1,169 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsSaml() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsSaml,
+		Read:   readSettingsSaml,
+		Update: updateSettingsSaml,
+		Delete: deleteSettingsSaml,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether users can log in through the SAML identity provider.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Identity provider. Can be set to 'okta', 'gsuite', 'ping', 'shibboleth', 'azure', or 'adfs'.",
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						settings.SamlTypeOkta,
+						settings.SamlTypeGsuite,
+						settings.SamlTypePing,
+						settings.SamlTypeShibboleth,
+						settings.SamlTypeAzure,
+						settings.SamlTypeAdfs,
+					},
+					false,
+				),
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Single sign-on URL of the identity provider.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Entity ID of the identity provider.",
+			},
+			"cert": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded signing certificate of the identity provider.",
+			},
+			"audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Entity ID of the Console, as configured in the identity provider.",
+			},
+			"console_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the Console that the identity provider redirects to.",
+			},
+			"provider_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the identity provider shown on the login page.",
+			},
+			"app_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Application ID, for Azure Active Directory group lookups.",
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Tenant ID, for Azure Active Directory group lookups.",
+			},
+			"app_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Application secret, for Azure Active Directory group lookups.",
+			},
+		},
+	}
+}
+
+func parseSettingsSaml(d *schema.ResourceData) settings.Saml {
+	return settings.Saml{
+		Enabled:       d.Get("enabled").(bool),
+		Type:          d.Get("type").(string),
+		Url:           d.Get("url").(string),
+		Issuer:        d.Get("issuer").(string),
+		Cert:          d.Get("cert").(string),
+		Audience:      d.Get("audience").(string),
+		ConsoleUrl:    d.Get("console_url").(string),
+		ProviderAlias: d.Get("provider_alias").(string),
+		AppId:         d.Get("app_id").(string),
+		TenantId:      d.Get("tenant_id").(string),
+		AppSecret:     settingsSecret(d, "app_secret"),
+	}
+}
+
+func saveSettingsSaml(d *schema.ResourceData, obj settings.Saml) {
+	d.Set("enabled", obj.Enabled)
+	d.Set("type", obj.Type)
+	d.Set("url", obj.Url)
+	d.Set("issuer", obj.Issuer)
+	d.Set("cert", obj.Cert)
+	d.Set("audience", obj.Audience)
+	d.Set("console_url", obj.ConsoleUrl)
+	d.Set("provider_alias", obj.ProviderAlias)
+	d.Set("app_id", obj.AppId)
+	d.Set("tenant_id", obj.TenantId)
+}
+
+func readSettingsSaml(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetSaml(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.SamlName)
+	saveSettingsSaml(d, obj)
+
+	return nil
+}
+
+func updateSettingsSaml(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsSaml(d)
+
+	if err := settings.UpdateSaml(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.SamlName)
+	return readSettingsSaml(d, meta)
+}
+
+func deleteSettingsSaml(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateSaml(client, settings.DefaultSaml); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,166 @@
+package prismacloudcompute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSettingsSamlConfig(t *testing.T) {
+	var o settings.Saml
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSettingsSamlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsSamlConfig("http://www.okta.com/exk1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsSamlExists("prismacloudcompute_settings_saml.test", &o),
+					testAccCheckSettingsSamlAttributes(&o, "http://www.okta.com/exk1"),
+				),
+			},
+			{
+				Config: testAccSettingsSamlConfig("http://www.okta.com/exk2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsSamlExists("prismacloudcompute_settings_saml.test", &o),
+					testAccCheckSettingsSamlAttributes(&o, "http://www.okta.com/exk2"),
+				),
+			},
+		},
+	})
+}
+
+func TestSettingsSamlKeepsUnmanagedFields(t *testing.T) {
+	doc := map[string]interface{}{
+		"enabled":          true,
+		"type":             settings.SamlTypeAzure,
+		"issuer":           "https://sts.windows.net/tenant/",
+		"appSecret":        map[string]interface{}{"encrypted": "opaque"},
+		"skipAuthnContext": true,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/authenticate":
+			fmt.Fprint(w, `{"token": "opaque"}`)
+		case r.URL.Path == "/api/v1/settings/saml" && r.Method == "GET":
+			json.NewEncoder(w).Encode(doc)
+		case r.URL.Path == "/api/v1/settings/saml" && r.Method == "POST":
+			doc = nil
+			json.NewDecoder(r.Body).Decode(&doc)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := newApiClient(&pc.Client{
+		Url:      strings.TrimPrefix(srv.URL, "http://"),
+		Protocol: "http",
+		Username: "admin",
+		Password: "admin",
+		Logging:  map[string]bool{pc.LogQuiet: true},
+	})
+	if err := client.Initialize(""); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSettingsSaml().Schema, map[string]interface{}{
+		"type":   settings.SamlTypeOkta,
+		"url":    "https://example.okta.com/app/sso/saml",
+		"issuer": "http://www.okta.com/exk1",
+		"cert":   "-----BEGIN CERTIFICATE-----",
+	})
+	if err := updateSettingsSaml(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if doc["type"] != settings.SamlTypeOkta || doc["issuer"] != "http://www.okta.com/exk1" {
+		t.Errorf("managed fields were not updated: %v", doc)
+	}
+	if doc["skipAuthnContext"] != true {
+		t.Errorf("update dropped a field the resource does not manage: %v", doc)
+	}
+	if secret, _ := doc["appSecret"].(map[string]interface{}); secret["encrypted"] != "opaque" {
+		t.Errorf("update dropped a secret that is not configured: %v", doc["appSecret"])
+	}
+
+	if err := deleteSettingsSaml(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if doc["enabled"] != false || doc["issuer"] != "" {
+		t.Errorf("delete did not restore the defaults: %v", doc)
+	}
+	if doc["skipAuthnContext"] != true {
+		t.Errorf("delete dropped a field the resource does not manage: %v", doc)
+	}
+}
+
+func testAccCheckSettingsSamlExists(n string, o *settings.Saml) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := settings.GetSaml(client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckSettingsSamlAttributes(o *settings.Saml, issuer string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !o.Enabled {
+			return fmt.Errorf("SAML is not enabled")
+		}
+
+		if o.Issuer != issuer {
+			return fmt.Errorf("Issuer is %s, expected %s", o.Issuer, issuer)
+		}
+
+		return nil
+	}
+}
+
+func testAccSettingsSamlDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	o, err := settings.GetSaml(client)
+	if err != nil {
+		return fmt.Errorf("Error in get: %s", err)
+	}
+	if o.Enabled {
+		return fmt.Errorf("SAML is still enabled")
+	}
+
+	return nil
+}
+
+func testAccSettingsSamlConfig(issuer string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_settings_saml" "test" {
+    type = "okta"
+    url = "https://example.okta.com/app/example/sso/saml"
+    issuer = %q
+    cert = <<EOT
+-----BEGIN CERTIFICATE-----
+MIIBkTCB+wIJAKHBfpegPjMCMA0GCSqGSIb3DQEBCwUAMA0xCzAJBgNVBAYTAlVT
+-----END CERTIFICATE-----
+EOT
+}`, issuer)
+}
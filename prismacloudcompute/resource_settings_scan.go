@@ -0,0 +1,177 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The Console stores scan periods in milliseconds, but they are set in
+// whole hours in the UI.
+const msPerHour = int(time.Hour / time.Millisecond)
+
+// scanPeriodHours converts a scan period to hours.  Periods that are not
+// whole hours, which only the API can set, are rounded up, so that a period
+// under an hour does not read back as zero.
+func scanPeriodHours(ms int) int {
+	return (ms + msPerHour - 1) / msPerHour
+}
+
+func scanPeriodSchema(what string, defaultMs int) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      defaultMs / msPerHour,
+		Description:  fmt.Sprintf("Hours between scans of %s.", what),
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+func resourceSettingsScan() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsScan,
+		Read:   readSettingsScan,
+		Update: updateSettingsScan,
+		Delete: deleteSettingsScan,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project":                           projectSchema(),
+			"images_scan_period_hours":          scanPeriodSchema("images on hosts", settings.DefaultScan.ImagesScanPeriodMs),
+			"containers_scan_period_hours":      scanPeriodSchema("running containers", settings.DefaultScan.ContainersScanPeriodMs),
+			"hosts_scan_period_hours":           scanPeriodSchema("hosts", settings.DefaultScan.SystemScanPeriodMs),
+			"registry_scan_period_hours":        scanPeriodSchema("registries", settings.DefaultScan.RegistryScanPeriodMs),
+			"serverless_scan_period_hours":      scanPeriodSchema("serverless functions", settings.DefaultScan.ServerlessScanPeriodMs),
+			"vm_scan_period_hours":              scanPeriodSchema("VM images", settings.DefaultScan.VmScanPeriodMs),
+			"tas_scan_period_hours":             scanPeriodSchema("VMware Tanzu droplets", settings.DefaultScan.TasScanPeriodMs),
+			"cloud_platforms_scan_period_hours": scanPeriodSchema("cloud platforms", settings.DefaultScan.CloudPlatformsScanPeriodMs),
+			"code_repos_scan_period_hours":      scanPeriodSchema("code repositories", settings.DefaultScan.CodeReposScanPeriodMs),
+			"scan_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      settings.DefaultScan.ScanConcurrency,
+				Description:  "Number of images each Defender scans at the same time.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"registry_scan_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      settings.DefaultScan.RegistryScanRetentionDays,
+				Description:  "Days to keep scan results of images that are no longer in a registry. Zero keeps them until the next registry scan.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"scan_running_images": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     settings.DefaultScan.ScanRunningImages,
+				Description: "Only scan images that have running containers.",
+			},
+			"show_negligible_vulnerabilities": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     settings.DefaultScan.ShowNegligibleVulnerabilities,
+				Description: "Show vulnerabilities of negligible severity in scan results.",
+			},
+			"show_infra_containers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     settings.DefaultScan.ShowInfraContainers,
+				Description: "Show containers of the container platform, such as Kubernetes pause containers, in scan results.",
+			},
+			"extract_archive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     settings.DefaultScan.ExtractArchive,
+				Description: "Scan the contents of archives, such as zip files, in images.",
+			},
+		},
+	}
+}
+
+func parseSettingsScan(d *schema.ResourceData) settings.Scan {
+	return settings.Scan{
+		ImagesScanPeriodMs:            d.Get("images_scan_period_hours").(int) * msPerHour,
+		ContainersScanPeriodMs:        d.Get("containers_scan_period_hours").(int) * msPerHour,
+		SystemScanPeriodMs:            d.Get("hosts_scan_period_hours").(int) * msPerHour,
+		RegistryScanPeriodMs:          d.Get("registry_scan_period_hours").(int) * msPerHour,
+		ServerlessScanPeriodMs:        d.Get("serverless_scan_period_hours").(int) * msPerHour,
+		VmScanPeriodMs:                d.Get("vm_scan_period_hours").(int) * msPerHour,
+		TasScanPeriodMs:               d.Get("tas_scan_period_hours").(int) * msPerHour,
+		CloudPlatformsScanPeriodMs:    d.Get("cloud_platforms_scan_period_hours").(int) * msPerHour,
+		CodeReposScanPeriodMs:         d.Get("code_repos_scan_period_hours").(int) * msPerHour,
+		ScanConcurrency:               d.Get("scan_concurrency").(int),
+		RegistryScanRetentionDays:     d.Get("registry_scan_retention_days").(int),
+		ScanRunningImages:             d.Get("scan_running_images").(bool),
+		ShowNegligibleVulnerabilities: d.Get("show_negligible_vulnerabilities").(bool),
+		ShowInfraContainers:           d.Get("show_infra_containers").(bool),
+		ExtractArchive:                d.Get("extract_archive").(bool),
+	}
+}
+
+func saveSettingsScan(d *schema.ResourceData, obj settings.Scan) {
+	d.Set("images_scan_period_hours", scanPeriodHours(obj.ImagesScanPeriodMs))
+	d.Set("containers_scan_period_hours", scanPeriodHours(obj.ContainersScanPeriodMs))
+	d.Set("hosts_scan_period_hours", scanPeriodHours(obj.SystemScanPeriodMs))
+	d.Set("registry_scan_period_hours", scanPeriodHours(obj.RegistryScanPeriodMs))
+	d.Set("serverless_scan_period_hours", scanPeriodHours(obj.ServerlessScanPeriodMs))
+	d.Set("vm_scan_period_hours", scanPeriodHours(obj.VmScanPeriodMs))
+	d.Set("tas_scan_period_hours", scanPeriodHours(obj.TasScanPeriodMs))
+	d.Set("cloud_platforms_scan_period_hours", scanPeriodHours(obj.CloudPlatformsScanPeriodMs))
+	d.Set("code_repos_scan_period_hours", scanPeriodHours(obj.CodeReposScanPeriodMs))
+	d.Set("scan_concurrency", obj.ScanConcurrency)
+	d.Set("registry_scan_retention_days", obj.RegistryScanRetentionDays)
+	d.Set("scan_running_images", obj.ScanRunningImages)
+	d.Set("show_negligible_vulnerabilities", obj.ShowNegligibleVulnerabilities)
+	d.Set("show_infra_containers", obj.ShowInfraContainers)
+	d.Set("extract_archive", obj.ExtractArchive)
+}
+
+func readSettingsScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetScan(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.ScanName)
+	saveSettingsScan(d, obj)
+
+	return nil
+}
+
+func updateSettingsScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsScan(d)
+
+	if err := settings.UpdateScan(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.ScanName)
+	return readSettingsScan(d, meta)
+}
+
+func deleteSettingsScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateScan(client, settings.DefaultScan); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
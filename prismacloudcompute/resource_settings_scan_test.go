@@ -0,0 +1,115 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSettingsScanConfig(t *testing.T) {
+	var o settings.Scan
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSettingsScanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsScanConfig(12, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsScanExists("prismacloudcompute_settings_scan.test", &o),
+					testAccCheckSettingsScanAttributes(&o, 12, true),
+				),
+			},
+			{
+				Config: testAccSettingsScanConfig(48, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSettingsScanExists("prismacloudcompute_settings_scan.test", &o),
+					testAccCheckSettingsScanAttributes(&o, 48, false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingsScanExists(n string, o *settings.Scan) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := settings.GetScan(client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckSettingsScanAttributes(o *settings.Scan, hours int, negligible bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.ImagesScanPeriodMs != hours*msPerHour {
+			return fmt.Errorf("Images scan period is %dms, expected %d hours", o.ImagesScanPeriodMs, hours)
+		}
+
+		if o.ShowNegligibleVulnerabilities != negligible {
+			return fmt.Errorf("Show negligible vulnerabilities is %t, expected %t", o.ShowNegligibleVulnerabilities, negligible)
+		}
+
+		return nil
+	}
+}
+
+func testAccSettingsScanDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	o, err := settings.GetScan(client)
+	if err != nil {
+		return fmt.Errorf("Error in get: %s", err)
+	}
+	if o != settings.DefaultScan {
+		return fmt.Errorf("Scan settings were not restored: %#v", o)
+	}
+
+	return nil
+}
+
+func testAccSettingsScanConfig(hours int, negligible bool) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_settings_scan" "test" {
+    images_scan_period_hours = %d
+    show_negligible_vulnerabilities = %t
+}`, hours, negligible)
+}
+
+func TestSaveSettingsScanPartialHours(t *testing.T) {
+	obj := settings.DefaultScan
+	obj.ImagesScanPeriodMs = 30 * 60 * 1000
+	obj.RegistryScanPeriodMs = 90 * 60 * 1000
+	obj.VmScanPeriodMs = 6 * msPerHour
+
+	d := schema.TestResourceDataRaw(t, resourceSettingsScan().Schema, map[string]interface{}{})
+	saveSettingsScan(d, obj)
+
+	expected := map[string]int{
+		"images_scan_period_hours":   1,
+		"registry_scan_period_hours": 2,
+		"vm_scan_period_hours":       6,
+	}
+	for key, hours := range expected {
+		if got := d.Get(key).(int); got != hours {
+			t.Errorf("%s is %d, expected %d", key, got, hours)
+		}
+		if _, errs := resourceSettingsScan().Schema[key].ValidateFunc(d.Get(key), key); len(errs) != 0 {
+			t.Errorf("%s read back as an invalid value: %v", key, errs)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/paloaltonetworks/prisma-cloud-compute-go/collection"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsServerlessScan() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsServerlessScan,
+		Read:   readSettingsServerlessScan,
+		Update: updateSettingsServerlessScan,
+		Delete: deleteSettingsServerlessScan,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"account": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Cloud accounts whose serverless functions are scanned.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Cloud provider of the account. Can be set to 'aws', 'azure', or 'gcp'.",
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									settings.CloudProviderAws,
+									settings.CloudProviderAzure,
+									settings.CloudProviderGcp,
+								},
+								false,
+							),
+						},
+						"credential_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the credential, in the Console's credentials store, used to access the account.",
+						},
+						"regions": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Regions to scan. All regions are scanned if not set.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"functions": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Names of the functions to scan. Wildcards are supported, as in collections. All functions are scanned if not set.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"scan_layers": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Also scan the layers of the functions.",
+						},
+						"cap": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Maximum number of functions to scan in the account. Zero scans all of them.",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// functionsCollection scopes to the functions matching the patterns, the
+// same way a collection that only sets functions does.
+func functionsCollection(patterns []string) collection.Collection {
+	if len(patterns) == 0 {
+		patterns = []string{"*"}
+	}
+
+	all := []string{"*"}
+	return collection.Collection{
+		AccountIDs: all,
+		AppIDs:     all,
+		Clusters:   all,
+		CodeRepos:  all,
+		Containers: all,
+		Functions:  patterns,
+		Hosts:      all,
+		Images:     all,
+		Labels:     all,
+		Namespaces: all,
+	}
+}
+
+func parseSettingsServerlessScan(d *schema.ResourceData) settings.ServerlessScan {
+	obj := settings.ServerlessScan{
+		Accounts: []settings.ServerlessScanAccount{},
+	}
+
+	for _, item := range d.Get("account").([]interface{}) {
+		x := item.(map[string]interface{})
+		obj.Accounts = append(obj.Accounts, settings.ServerlessScanAccount{
+			Provider:     x["provider"].(string),
+			CredentialId: x["credential_id"].(string),
+			Regions:      SetToStringSlice(x["regions"].(*schema.Set)),
+			Collections: []collection.Collection{
+				functionsCollection(ListToStringSlice(x["functions"].([]interface{}))),
+			},
+			ScanLayers: x["scan_layers"].(bool),
+			Cap:        x["cap"].(int),
+		})
+	}
+
+	return obj
+}
+
+func saveSettingsServerlessScan(d *schema.ResourceData, obj settings.ServerlessScan) {
+	accounts := make([]interface{}, 0, len(obj.Accounts))
+	for _, x := range obj.Accounts {
+		var functions []string
+		for _, coll := range x.Collections {
+			functions = append(functions, coll.Functions...)
+		}
+		if len(functions) == 1 && functions[0] == "*" {
+			functions = nil
+		}
+
+		accounts = append(accounts, map[string]interface{}{
+			"provider":      x.Provider,
+			"credential_id": x.CredentialId,
+			"regions":       StringSliceToSet(x.Regions),
+			"functions":     functions,
+			"scan_layers":   x.ScanLayers,
+			"cap":           x.Cap,
+		})
+	}
+
+	d.Set("account", accounts)
+}
+
+func readSettingsServerlessScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetServerlessScan(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.ServerlessScanName)
+	saveSettingsServerlessScan(d, obj)
+
+	return nil
+}
+
+func updateSettingsServerlessScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsServerlessScan(d)
+
+	if err := settings.UpdateServerlessScan(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.ServerlessScanName)
+	return readSettingsServerlessScan(d, meta)
+}
+
+func deleteSettingsServerlessScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateServerlessScan(client, settings.DefaultServerlessScan); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,57 @@
+package prismacloudcompute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestParseSettingsServerlessScan(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSettingsServerlessScan().Schema, map[string]interface{}{
+		"account": []interface{}{
+			map[string]interface{}{
+				"provider":      "aws",
+				"credential_id": "aws-prod",
+				"regions":       []interface{}{"us-east-1"},
+				"functions":     []interface{}{"payments-*", "billing-*"},
+				"cap":           100,
+			},
+			map[string]interface{}{
+				"provider":      "azure",
+				"credential_id": "azure-prod",
+				"scan_layers":   false,
+			},
+		},
+	})
+
+	obj := parseSettingsServerlessScan(d)
+	if len(obj.Accounts) != 2 {
+		t.Fatalf("got %d accounts, expected 2", len(obj.Accounts))
+	}
+
+	aws := obj.Accounts[0]
+	if len(aws.Collections) != 1 || !reflect.DeepEqual(aws.Collections[0].Functions, []string{"payments-*", "billing-*"}) {
+		t.Errorf("collections are %#v", aws.Collections)
+	}
+	if !reflect.DeepEqual(aws.Collections[0].Images, []string{"*"}) {
+		t.Errorf("collection is not only scoped by functions: %#v", aws.Collections[0])
+	}
+	if !aws.ScanLayers || aws.Cap != 100 {
+		t.Errorf("scan layers is %t and cap is %d", aws.ScanLayers, aws.Cap)
+	}
+
+	azure := obj.Accounts[1]
+	if !reflect.DeepEqual(azure.Collections[0].Functions, []string{"*"}) || azure.ScanLayers {
+		t.Errorf("account is %#v", azure)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSettingsServerlessScan().Schema, map[string]interface{}{})
+	saveSettingsServerlessScan(d, obj)
+	if v := d.Get("account.0.functions").([]interface{}); len(v) != 2 {
+		t.Errorf("functions are %v after a round trip", v)
+	}
+	if v := d.Get("account.1.functions").([]interface{}); len(v) != 0 {
+		t.Errorf("functions are %v after a round trip, expected none for all functions", v)
+	}
+}
@@ -0,0 +1,142 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsTasScan() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsTasScan,
+		Read:   readSettingsTasScan,
+		Update: updateSettingsTasScan,
+		Delete: deleteSettingsTasScan,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"specification": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifications of the VMware Tanzu droplets to scan in blobstores.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_controller_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "URL of the Cloud Controller of the foundation, such as 'https://api.sys.example.com'.",
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"credential_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the credential, in the Console's credentials store, used to access the blobstore.",
+						},
+						"pattern": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "*",
+							Description: "Name of the applications whose droplets are scanned. Wildcards are supported.",
+						},
+						"excluded_apps": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Names of applications whose droplets are not scanned. Wildcards are supported.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"cap":      specCapSchema("droplets of each application"),
+						"scanners": specScannersSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func parseSettingsTasScan(d *schema.ResourceData) settings.TasScan {
+	obj := settings.TasScan{
+		Specifications: []settings.TasSpec{},
+	}
+
+	for _, item := range d.Get("specification").([]interface{}) {
+		x := item.(map[string]interface{})
+		obj.Specifications = append(obj.Specifications, settings.TasSpec{
+			CloudControllerAddress: x["cloud_controller_address"].(string),
+			CredentialId:           x["credential_id"].(string),
+			Pattern:                x["pattern"].(string),
+			ExcludedApps:           ListToStringSlice(x["excluded_apps"].([]interface{})),
+			Cap:                    x["cap"].(int),
+			Scanners:               x["scanners"].(int),
+		})
+	}
+
+	return obj
+}
+
+func saveSettingsTasScan(d *schema.ResourceData, obj settings.TasScan) {
+	specs := make([]interface{}, 0, len(obj.Specifications))
+	for _, x := range obj.Specifications {
+		specs = append(specs, map[string]interface{}{
+			"cloud_controller_address": x.CloudControllerAddress,
+			"credential_id":            x.CredentialId,
+			"pattern":                  x.Pattern,
+			"excluded_apps":            x.ExcludedApps,
+			"cap":                      x.Cap,
+			"scanners":                 x.Scanners,
+		})
+	}
+
+	d.Set("specification", specs)
+}
+
+func readSettingsTasScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetTasScan(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.TasScanName)
+	saveSettingsTasScan(d, obj)
+
+	return nil
+}
+
+func updateSettingsTasScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsTasScan(d)
+
+	if err := settings.UpdateTasScan(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.TasScanName)
+	return readSettingsTasScan(d, meta)
+}
+
+func deleteSettingsTasScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateTasScan(client, settings.DefaultTasScan); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,51 @@
+package prismacloudcompute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestParseSettingsTasScan(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSettingsTasScan().Schema, map[string]interface{}{
+		"specification": []interface{}{
+			map[string]interface{}{
+				"cloud_controller_address": "https://api.sys.example.com",
+				"credential_id":            "tas-blobstore",
+				"excluded_apps":            []interface{}{"smoke-*"},
+				"scanners":                 2,
+			},
+		},
+	})
+
+	obj := parseSettingsTasScan(d)
+	expected := settings.TasScan{
+		Specifications: []settings.TasSpec{
+			{
+				CloudControllerAddress: "https://api.sys.example.com",
+				CredentialId:           "tas-blobstore",
+				Pattern:                "*",
+				ExcludedApps:           []string{"smoke-*"},
+				Cap:                    5,
+				Scanners:               2,
+			},
+		},
+	}
+	if !reflect.DeepEqual(obj, expected) {
+		t.Errorf("got %#v, expected %#v", obj, expected)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSettingsTasScan().Schema, map[string]interface{}{})
+	saveSettingsTasScan(d, obj)
+	if again := parseSettingsTasScan(d); !reflect.DeepEqual(again, expected) {
+		t.Errorf("got %#v after a round trip", again)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSettingsTasScan().Schema, map[string]interface{}{})
+	if obj := parseSettingsTasScan(d); obj.Specifications == nil {
+		t.Error("specifications are null instead of empty")
+	}
+}
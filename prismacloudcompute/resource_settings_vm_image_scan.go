@@ -0,0 +1,156 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceSettingsVmImageScan() *schema.Resource {
+	return &schema.Resource{
+		Create: updateSettingsVmImageScan,
+		Read:   readSettingsVmImageScan,
+		Update: updateSettingsVmImageScan,
+		Delete: deleteSettingsVmImageScan,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"specification": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifications of the VM images to scan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Cloud provider of the images. Can be set to 'aws', 'azure', or 'gcp'.",
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									settings.CloudProviderAws,
+									settings.CloudProviderAzure,
+									settings.CloudProviderGcp,
+								},
+								false,
+							),
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Region of the images. Required for AWS.",
+						},
+						"credential_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the credential, in the Console's credentials store, used to access the images.",
+						},
+						"image": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "*",
+							Description: "Name of the images to scan. Wildcards are supported.",
+						},
+						"excluded_images": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Names of images not to scan. Wildcards are supported.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"cap":      specCapSchema("images"),
+						"scanners": specScannersSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func parseSettingsVmImageScan(d *schema.ResourceData) settings.VmImageScan {
+	obj := settings.VmImageScan{
+		Specifications: []settings.VmImageSpec{},
+	}
+
+	for _, item := range d.Get("specification").([]interface{}) {
+		x := item.(map[string]interface{})
+		obj.Specifications = append(obj.Specifications, settings.VmImageSpec{
+			Provider:       x["provider"].(string),
+			Region:         x["region"].(string),
+			CredentialId:   x["credential_id"].(string),
+			Image:          x["image"].(string),
+			ExcludedImages: ListToStringSlice(x["excluded_images"].([]interface{})),
+			Cap:            x["cap"].(int),
+			Scanners:       x["scanners"].(int),
+		})
+	}
+
+	return obj
+}
+
+func saveSettingsVmImageScan(d *schema.ResourceData, obj settings.VmImageScan) {
+	specs := make([]interface{}, 0, len(obj.Specifications))
+	for _, x := range obj.Specifications {
+		specs = append(specs, map[string]interface{}{
+			"provider":        x.Provider,
+			"region":          x.Region,
+			"credential_id":   x.CredentialId,
+			"image":           x.Image,
+			"excluded_images": x.ExcludedImages,
+			"cap":             x.Cap,
+			"scanners":        x.Scanners,
+		})
+	}
+
+	d.Set("specification", specs)
+}
+
+func readSettingsVmImageScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := settings.GetVmImageScan(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(settings.VmImageScanName)
+	saveSettingsVmImageScan(d, obj)
+
+	return nil
+}
+
+func updateSettingsVmImageScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseSettingsVmImageScan(d)
+
+	if err := settings.UpdateVmImageScan(client, obj); err != nil {
+		return err
+	}
+
+	d.SetId(settings.VmImageScanName)
+	return readSettingsVmImageScan(d, meta)
+}
+
+func deleteSettingsVmImageScan(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := settings.UpdateVmImageScan(client, settings.DefaultVmImageScan); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,54 @@
+package prismacloudcompute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestParseSettingsVmImageScan(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSettingsVmImageScan().Schema, map[string]interface{}{
+		"specification": []interface{}{
+			map[string]interface{}{
+				"provider":        "aws",
+				"region":          "us-east-1",
+				"credential_id":   "aws-prod",
+				"image":           "golden-*",
+				"excluded_images": []interface{}{"golden-test-*"},
+				"scanners":        4,
+			},
+		},
+	})
+
+	obj := parseSettingsVmImageScan(d)
+	expected := settings.VmImageScan{
+		Specifications: []settings.VmImageSpec{
+			{
+				Provider:       settings.CloudProviderAws,
+				Region:         "us-east-1",
+				CredentialId:   "aws-prod",
+				Image:          "golden-*",
+				ExcludedImages: []string{"golden-test-*"},
+				Cap:            5,
+				Scanners:       4,
+			},
+		},
+	}
+	if !reflect.DeepEqual(obj, expected) {
+		t.Errorf("got %#v, expected %#v", obj, expected)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSettingsVmImageScan().Schema, map[string]interface{}{})
+	saveSettingsVmImageScan(d, obj)
+	if again := parseSettingsVmImageScan(d); !reflect.DeepEqual(again, expected) {
+		t.Errorf("got %#v after a round trip", again)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSettingsVmImageScan().Schema, map[string]interface{}{})
+	if obj := parseSettingsVmImageScan(d); obj.Specifications == nil {
+		t.Error("specifications are null instead of empty")
+	}
+}
@@ -0,0 +1,130 @@
+package prismacloudcompute
+
+import (
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/tag"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceTag() *schema.Resource {
+	return &schema.Resource{
+		Create: createTag,
+		Read:   readTag,
+		Update: updateTag,
+		Delete: deleteTag,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique tag name.",
+			},
+			"color": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "A hex color code for the tag.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A free-form text description of the tag.",
+			},
+		},
+	}
+}
+
+func parseTag(d *schema.ResourceData) tag.Tag {
+	return tag.Tag{
+		Name:        d.Get("name").(string),
+		Color:       d.Get("color").(string),
+		Description: d.Get("description").(string),
+	}
+}
+
+func saveTag(d *schema.ResourceData, obj tag.Tag) {
+	d.Set("name", obj.Name)
+	d.Set("color", obj.Color)
+	d.Set("description", obj.Description)
+}
+
+func createTag(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseTag(d)
+
+	if err := tag.Create(client, obj); err != nil {
+		return err
+	}
+
+	PollApiUntilSuccess(func() error {
+		_, err := tag.Get(client, obj.Name)
+		return err
+	})
+
+	d.SetId(obj.Name)
+	return readTag(d, meta)
+}
+
+func readTag(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	obj, err := tag.Get(client, d.Id())
+	if err != nil {
+		if err == pc.ObjectNotFoundError {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	saveTag(d, obj)
+
+	return nil
+}
+
+func updateTag(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	obj := parseTag(d)
+
+	// Updating the tag itself replaces its assignments, so carry over the
+	// ones currently in place; those are managed by prismacloudcompute_tag_assignment.
+	current, err := tag.Get(client, d.Id())
+	if err != nil {
+		return err
+	}
+	obj.Vulns = current.Vulns
+
+	if err := tag.Update(client, d.Id(), obj); err != nil {
+		return err
+	}
+
+	d.SetId(obj.Name)
+	return readTag(d, meta)
+}
+
+func deleteTag(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	if err := tag.Delete(client, d.Id()); err != nil {
+		if err != pc.ObjectNotFoundError {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,186 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pc "github.com/paloaltonetworks/prisma-cloud-compute-go"
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/tag"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceTagAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: createTagAssignment,
+		Read:   readTagAssignment,
+		Delete: deleteTagAssignment,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": projectSchema(),
+			"tag": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the tag to assign.",
+			},
+			"cve": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CVE ID the tag is assigned to.",
+			},
+			"package_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "*",
+				Description: "Package the CVE is found in. Defaults to all packages.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     tag.ResourceTypeImage,
+				Description: "Type of resource the CVE is found in. Can be set to 'image', 'host', 'function', or 'codeRepo'.",
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						tag.ResourceTypeImage,
+						tag.ResourceTypeHost,
+						tag.ResourceTypeFunction,
+						tag.ResourceTypeCodeRepo,
+					},
+					false,
+				),
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "*",
+				Description: "Name of the resource the CVE is found in. Defaults to all resources of the given type.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Free-form text for documenting the assignment.",
+			},
+		},
+	}
+}
+
+/*
+tagAssignmentId escapes the separator in each part, as tag, package and image
+names can all contain it.  The resource name goes last so that IDs written by
+hand, such as import IDs, can leave the separator in it unescaped.
+*/
+func tagAssignmentId(name string, vuln tag.Vuln) string {
+	parts := []string{name, vuln.Id, vuln.ResourceType, vuln.PackageName, vuln.ResourceName}
+	for i := range parts {
+		parts[i] = EscapeIdPart(parts[i])
+	}
+
+	return strings.Join(parts, IdSeparator)
+}
+
+func parseTagAssignmentId(id string) (string, tag.Vuln, error) {
+	t := strings.SplitN(id, IdSeparator, 5)
+	if len(t) != 5 {
+		return "", tag.Vuln{}, fmt.Errorf("Invalid tag assignment ID %q, expected tag:cve:resource_type:package_name:resource_name", id)
+	}
+	for i := range t {
+		t[i] = UnescapeIdPart(t[i])
+	}
+
+	return t[0], tag.Vuln{
+		Id:           t[1],
+		ResourceType: t[2],
+		PackageName:  t[3],
+		ResourceName: t[4],
+	}, nil
+}
+
+func parseTagAssignment(d *schema.ResourceData) (string, tag.Vuln) {
+	return d.Get("tag").(string), tag.Vuln{
+		Id:           d.Get("cve").(string),
+		PackageName:  d.Get("package_name").(string),
+		ResourceType: d.Get("resource_type").(string),
+		ResourceName: d.Get("resource_name").(string),
+		Comment:      d.Get("comment").(string),
+	}
+}
+
+func saveTagAssignment(d *schema.ResourceData, name string, obj tag.Vuln) {
+	d.Set("tag", name)
+	d.Set("cve", obj.Id)
+	d.Set("package_name", obj.PackageName)
+	d.Set("resource_type", obj.ResourceType)
+	d.Set("resource_name", obj.ResourceName)
+	d.Set("comment", obj.Comment)
+}
+
+func createTagAssignment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	name, obj := parseTagAssignment(d)
+
+	if err := tag.Assign(client, name, obj); err != nil {
+		return err
+	}
+
+	d.SetId(tagAssignmentId(name, obj))
+	return readTagAssignment(d, meta)
+}
+
+func readTagAssignment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+
+	name, want, err := parseTagAssignmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	obj, err := tag.Get(client, name)
+	if err != nil {
+		if err == pc.ObjectNotFoundError {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	for _, v := range obj.Vulns {
+		if v.Id == want.Id && v.ResourceType == want.ResourceType && v.PackageName == want.PackageName && v.ResourceName == want.ResourceName {
+			saveTagAssignment(d, name, v)
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func deleteTagAssignment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*apiClient).withProject(d)
+	name, obj := parseTagAssignment(d)
+
+	if err := tag.Unassign(client, name, obj); err != nil {
+		if err != pc.ObjectNotFoundError {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,145 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/tag"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTagAssignmentConfig(t *testing.T) {
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTagAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagAssignmentConfig(name, "CVE-2021-3449", "nginx:latest"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagAssignmentExists("prismacloudcompute_tag_assignment.test"),
+					resource.TestCheckResourceAttr("prismacloudcompute_tag_assignment.test", "package_name", "*"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_tag_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestTagAssignmentId(t *testing.T) {
+	cases := []struct {
+		name string
+		vuln tag.Vuln
+	}{
+		{"Ignored", tag.Vuln{Id: "CVE-2021-3449", ResourceType: tag.ResourceTypeImage, PackageName: "*", ResourceName: "nginx:latest"}},
+		{"risk:accepted", tag.Vuln{Id: "CVE-2021-3449", ResourceType: tag.ResourceTypeImage, PackageName: "openssl", ResourceName: "*"}},
+		{"Ignored", tag.Vuln{Id: "CVE-2021-44228", ResourceType: tag.ResourceTypeHost, PackageName: "org.apache.logging.log4j:log4j-core", ResourceName: "host:1"}},
+		{"100%", tag.Vuln{Id: "CVE-2021-3449", ResourceType: tag.ResourceTypeImage, PackageName: "open%3Assl", ResourceName: "*"}},
+	}
+
+	for _, tc := range cases {
+		id := tagAssignmentId(tc.name, tc.vuln)
+		name, vuln, err := parseTagAssignmentId(id)
+		if err != nil {
+			t.Errorf("%q: %s", id, err)
+		} else if name != tc.name || vuln != tc.vuln {
+			t.Errorf("%q: got %q %#v, expected %q %#v", id, name, vuln, tc.name, tc.vuln)
+		}
+	}
+
+	// Import IDs are written by hand, with the separator unescaped in the
+	// resource name.
+	name, vuln, err := parseTagAssignmentId("Ignored:CVE-2021-3449:image:openssl:nginx:latest")
+	if err != nil || name != "Ignored" || vuln.PackageName != "openssl" || vuln.ResourceName != "nginx:latest" {
+		t.Errorf("got %q %#v (%v)", name, vuln, err)
+	}
+
+	// A percent sign that is not one of the escapes is left alone.
+	name, vuln, err = parseTagAssignmentId("50%off:CVE-2021-3449:image:lib%zz:app%20name")
+	if err != nil || name != "50%off" || vuln.PackageName != "lib%zz" || vuln.ResourceName != "app%20name" {
+		t.Errorf("got %q %#v (%v)", name, vuln, err)
+	}
+
+	if _, _, err := parseTagAssignmentId("Ignored:CVE-2021-3449"); err == nil {
+		t.Error("expected an error for an ID with missing parts")
+	}
+}
+
+func testAccCheckTagAssignmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		name, want, err := parseTagAssignmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := tag.Get(client, name)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+
+		for _, v := range lo.Vulns {
+			if v.Id == want.Id && v.ResourceName == want.ResourceName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Tag %q is not assigned to %s", name, want.Id)
+	}
+}
+
+func testAccTagAssignmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_tag_assignment" {
+			continue
+		}
+
+		name, want, err := parseTagAssignmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		lo, err := tag.Get(client, name)
+		if err != nil {
+			continue
+		}
+
+		for _, v := range lo.Vulns {
+			if v.Id == want.Id && v.ResourceName == want.ResourceName {
+				return fmt.Errorf("Tag %q is still assigned to %s", name, want.Id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccTagAssignmentConfig(name, cve, image string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_tag" "test" {
+    name = %q
+    description = "Accepted risk"
+}
+
+resource "prismacloudcompute_tag_assignment" "test" {
+    tag = prismacloudcompute_tag.test.name
+    cve = %q
+    resource_name = %q
+}`, name, cve, image)
+}
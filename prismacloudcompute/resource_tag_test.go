@@ -0,0 +1,106 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/tag"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTagConfig(t *testing.T) {
+	var o tag.Tag
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig(name, "first", "#FF0000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists("prismacloudcompute_tag.test", &o),
+					testAccCheckTagAttributes(&o, name, "first", "#FF0000"),
+				),
+			},
+			{
+				Config: testAccTagConfig(name, "second", "#00FF00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists("prismacloudcompute_tag.test", &o),
+					testAccCheckTagAttributes(&o, name, "second", "#00FF00"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTagExists(n string, o *tag.Tag) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Object label Name is not set")
+		}
+
+		client := testAccProvider.Meta().(*apiClient)
+		lo, err := tag.Get(client, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckTagAttributes(o *tag.Tag, name, description, color string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.Name != name {
+			return fmt.Errorf("Name is %s, expected %s", o.Name, name)
+		}
+
+		if o.Description != description {
+			return fmt.Errorf("Description is %s, expected %s", o.Description, description)
+		}
+
+		if o.Color != color {
+			return fmt.Errorf("Color is %q, expected %q", o.Color, color)
+		}
+
+		return nil
+	}
+}
+
+func testAccTagDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_tag" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			if _, err := tag.Get(client, rs.Primary.ID); err == nil {
+				return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccTagConfig(name, description, color string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_tag" "test" {
+    name = %q
+    description = %q
+    color = %q
+}`, name, description, color)
+}
@@ -2,7 +2,6 @@ package prismacloudcompute
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/paloaltonetworks/prisma-cloud-compute-go/timerange"
 
@@ -18,6 +17,15 @@ func totalSchema(desc string) *schema.Schema {
 	}
 }
 
+func projectSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Project to manage this in, overriding the provider's project",
+	}
+}
+
 /*
 This function may need to be revisited..  Not happy with the "style" param
 and it makes an assumption that the param this time range is being saved to
@@ -173,7 +181,7 @@ func flattenTimeRange(t timerange.TimeRange) []interface{} {
 	}
 
 	if err := t.SetValue(); err != nil {
-		log.Printf("[WARN] time range SetValue failed: %s", err)
+		logWarn("error setting time range", "error", err)
 	}
 
 	switch v := t.Value.(type) {
@@ -0,0 +1,124 @@
+package prismacloudcompute
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-prismacloudcompute/internal/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+/*
+Settings resources manage a single document of the Console, so their ID is
+the name of that document.  The Console only returns secrets encrypted, so
+they are never read back into the state: the configured value is kept
+instead.
+*/
+
+func settingsSecret(d *schema.ResourceData, key string) *settings.Secret {
+	v := d.Get(key).(string)
+	if v == "" {
+		return nil
+	}
+
+	return &settings.Secret{Plain: v}
+}
+
+func cloudAccountsSchema(desc string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: desc,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"credential_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "ID of the credential, in the Console's credentials store, used to access the account.",
+				},
+				"provider": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Cloud provider of the account. Can be set to 'aws', 'azure', or 'gcp'.",
+					ValidateFunc: validation.StringInSlice(
+						[]string{
+							settings.CloudProviderAws,
+							settings.CloudProviderAzure,
+							settings.CloudProviderGcp,
+						},
+						false,
+					),
+				},
+				"regions": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Regions to scan. All regions are scanned if not set.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"services": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Services to scan, such as 'awsEc2' or 'gcpGke'. All services are scanned if not set.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func parseCloudAccounts(v []interface{}) []settings.CloudAccount {
+	ans := make([]settings.CloudAccount, 0, len(v))
+	for _, item := range v {
+		x := item.(map[string]interface{})
+		ans = append(ans, settings.CloudAccount{
+			CredentialId: x["credential_id"].(string),
+			Provider:     x["provider"].(string),
+			Regions:      SetToStringSlice(x["regions"].(*schema.Set)),
+			Services:     SetToStringSlice(x["services"].(*schema.Set)),
+		})
+	}
+
+	return ans
+}
+
+func flattenCloudAccounts(list []settings.CloudAccount) []interface{} {
+	ans := make([]interface{}, 0, len(list))
+	for _, x := range list {
+		ans = append(ans, map[string]interface{}{
+			"credential_id": x.CredentialId,
+			"provider":      x.Provider,
+			"regions":       StringSliceToSet(x.Regions),
+			"services":      StringSliceToSet(x.Services),
+		})
+	}
+
+	return ans
+}
+
+// Specifications of what to scan, as in VM image and VMware Tanzu scan
+// settings, default to the same counts as registry specifications.
+
+func specCapSchema(what string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      5,
+		Description:  fmt.Sprintf("Number of the most recent %s to scan. Zero scans all of them.", what),
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+}
+
+func specScannersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      2,
+		Description:  "Number of Defenders that scan in parallel.",
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
@@ -0,0 +1,239 @@
+package prismacloudcompute
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/http/httpguts"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// Minimum TLS versions accepted by `min_tls_version`.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// transportConfig holds the provider settings for the HTTP connection to
+// the Console.
+type transportConfig struct {
+	skipVerify    bool
+	caCertFile    string
+	caCertPem     string
+	clientCert    string
+	clientKey     string
+	minTlsVersion string
+	proxyUrl      string
+	noProxy       string
+	proxyUsername string
+	proxyPassword string
+	headers       map[string]string
+	logCalls      bool
+	logBodies     bool
+	perSecond     int
+	concurrent    int
+	cache         *responseCache
+}
+
+/*
+newTransport builds the transport handed to the SDK connection.
+
+The SDK builds its own transport only from `skip_ssl_cert_verification`, so
+everything else about the connection is configured here.
+*/
+func newTransport(tc transportConfig) (*http.Transport, error) {
+	tlsConfig, err := tc.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	proxy, err := tc.proxyFunc()
+	if err != nil {
+		return nil, err
+	}
+
+	base := &http.Transport{
+		Proxy:               proxy,
+		TLSClientConfig:     tlsConfig,
+		TLSHandshakeTimeout: http.DefaultTransport.(*http.Transport).TLSHandshakeTimeout,
+		IdleConnTimeout:     http.DefaultTransport.(*http.Transport).IdleConnTimeout,
+	}
+
+	var rt http.RoundTripper = base
+	if tc.logCalls {
+		rt = &loggingTransport{bodies: tc.logBodies, next: rt}
+	}
+	rt = newRateLimitTransport(rt, tc.perSecond, tc.concurrent)
+	if tc.cache != nil {
+		rt = &cacheTransport{cache: tc.cache, next: rt}
+	}
+	if len(tc.headers) > 0 {
+		rt = &headerTransport{headers: tc.headers, next: rt}
+	}
+
+	return roundTripperTransport(rt), nil
+}
+
+/*
+roundTripperTransport wraps a round tripper in the *http.Transport the SDK
+requires, by registering it for both protocols the Console can be reached on.
+*/
+func roundTripperTransport(rt http.RoundTripper) *http.Transport {
+	t := &http.Transport{
+		// Keep HTTP/2 from claiming the https protocol for itself.
+		TLSNextProto: map[string]func(string, *tls.Conn) http.RoundTripper{},
+	}
+	t.RegisterProtocol("http", rt)
+	t.RegisterProtocol("https", rt)
+
+	return t
+}
+
+/*
+proxyFunc returns how requests find their proxy.
+
+The standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables apply
+unless `proxy_url` or `no_proxy` replace them.
+*/
+func (tc transportConfig) proxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	conf := httpproxy.FromEnvironment()
+
+	if tc.proxyUrl != "" {
+		u, err := url.Parse(tc.proxyUrl)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("Invalid proxy_url %q", tc.proxyUrl)
+		}
+		conf.HTTPProxy = tc.proxyUrl
+		conf.HTTPSProxy = tc.proxyUrl
+	}
+	if tc.noProxy != "" {
+		conf.NoProxy = tc.noProxy
+	}
+
+	proxyForUrl := conf.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		u, err := proxyForUrl(req.URL)
+		if err != nil || u == nil {
+			return u, err
+		}
+
+		if tc.proxyUsername != "" {
+			proxy := *u
+			proxy.User = url.UserPassword(tc.proxyUsername, tc.proxyPassword)
+			return &proxy, nil
+		}
+		return u, nil
+	}, nil
+}
+
+// headerTransport adds the provider's `custom_headers` to every request.
+type headerTransport struct {
+	headers map[string]string
+	next    http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for key, val := range t.headers {
+		req.Header.Set(key, val)
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+func (tc transportConfig) tlsConfig() (*tls.Config, error) {
+	conf := &tls.Config{
+		InsecureSkipVerify: tc.skipVerify,
+	}
+
+	minTlsVersion := tc.minTlsVersion
+	if minTlsVersion == "" {
+		minTlsVersion = "1.2"
+	}
+	version, ok := tlsVersions[minTlsVersion]
+	if !ok {
+		return nil, fmt.Errorf("Invalid min_tls_version %q", minTlsVersion)
+	}
+	conf.MinVersion = version
+
+	if tc.caCertFile != "" || tc.caCertPem != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		if tc.caCertFile != "" {
+			b, err := ioutil.ReadFile(tc.caCertFile)
+			if err != nil {
+				return nil, fmt.Errorf("Error reading ca_cert_file: %s", err)
+			}
+			if !pool.AppendCertsFromPEM(b) {
+				return nil, fmt.Errorf("No PEM certificates found in ca_cert_file %q", tc.caCertFile)
+			}
+		}
+
+		if tc.caCertPem != "" && !pool.AppendCertsFromPEM([]byte(tc.caCertPem)) {
+			return nil, fmt.Errorf("No PEM certificates found in ca_cert_pem")
+		}
+
+		conf.RootCAs = pool
+	}
+
+	if tc.clientCert != "" || tc.clientKey != "" {
+		if tc.clientCert == "" || tc.clientKey == "" {
+			return nil, fmt.Errorf("client_cert and client_key must be specified together")
+		}
+
+		certPem, err := pemOrFile(tc.clientCert)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading client_cert: %s", err)
+		}
+		keyPem, err := pemOrFile(tc.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading client_key: %s", err)
+		}
+
+		cert, err := tls.X509KeyPair(certPem, keyPem)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid client_cert / client_key: %s", err)
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+
+	return conf, nil
+}
+
+// pemOrFile returns the value itself if it is PEM-encoded, otherwise the
+// contents of the file it names.
+func pemOrFile(v string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(v), "-----BEGIN") {
+		return []byte(v), nil
+	}
+
+	return ioutil.ReadFile(v)
+}
+
+// validateHeaders is the ValidateFunc of `custom_headers`.
+func validateHeaders(v interface{}, k string) (ws []string, es []error) {
+	headers, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, []error{fmt.Errorf("%s: expected a map", k)}
+	}
+
+	for name, val := range headers {
+		if !httpguts.ValidHeaderFieldName(name) {
+			es = append(es, fmt.Errorf("%s: invalid header name %q", k, name))
+		} else if s, _ := val.(string); !httpguts.ValidHeaderFieldValue(s) {
+			es = append(es, fmt.Errorf("%s: invalid value for header %q", k, name))
+		}
+	}
+
+	return ws, es
+}
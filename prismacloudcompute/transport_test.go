@@ -0,0 +1,187 @@
+package prismacloudcompute
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func certPem(srv *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+}
+
+func transportGet(t *testing.T, tc transportConfig, url string) error {
+	transport, err := newTransport(tc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Get(url)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return err
+}
+
+func TestTransportVerification(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "transport")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	caFile := filepath.Join(dir, "ca.pem")
+	if err := ioutil.WriteFile(caFile, []byte(certPem(srv)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := transportGet(t, transportConfig{}, srv.URL); err == nil {
+		t.Errorf("expected an untrusted certificate to be rejected by default")
+	}
+	if err := transportGet(t, transportConfig{skipVerify: true}, srv.URL); err != nil {
+		t.Errorf("skip verify: %s", err)
+	}
+	if err := transportGet(t, transportConfig{caCertPem: certPem(srv)}, srv.URL); err != nil {
+		t.Errorf("ca_cert_pem: %s", err)
+	}
+	if err := transportGet(t, transportConfig{caCertFile: caFile}, srv.URL); err != nil {
+		t.Errorf("ca_cert_file: %s", err)
+	}
+}
+
+func TestTransportMinTlsVersion(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	srv.StartTLS()
+	defer srv.Close()
+
+	if err := transportGet(t, transportConfig{caCertPem: certPem(srv), minTlsVersion: "1.2"}, srv.URL); err != nil {
+		t.Errorf("1.2: %s", err)
+	}
+	if err := transportGet(t, transportConfig{caCertPem: certPem(srv), minTlsVersion: "1.3"}, srv.URL); err == nil {
+		t.Errorf("1.3: expected the handshake with a TLS 1.2 server to fail")
+	}
+}
+
+func TestTransportClientCertificate(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	key, err := x509.MarshalPKCS8PrivateKey(srv.TLS.Certificates[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPem := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}))
+
+	conf, err := transportConfig{clientCert: certPem(srv), clientKey: keyPem}.tlsConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conf.Certificates) != 1 {
+		t.Errorf("got %d client certificates, expected 1", len(conf.Certificates))
+	}
+
+	if _, err := (transportConfig{clientCert: certPem(srv)}).tlsConfig(); err == nil {
+		t.Errorf("expected an error for client_cert without client_key")
+	}
+	if _, err := (transportConfig{caCertPem: "not a certificate"}).tlsConfig(); err == nil {
+		t.Errorf("expected an error for ca_cert_pem without certificates")
+	}
+}
+
+func TestTransportProxy(t *testing.T) {
+	var proxied *http.Request
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r
+	}))
+	defer proxy.Close()
+
+	tc := transportConfig{
+		proxyUrl:      proxy.URL,
+		proxyUsername: "proxy-user",
+		proxyPassword: "proxy-pass",
+		headers:       map[string]string{"X-Waf-Token": "abc"},
+	}
+	if err := transportGet(t, tc, "http://console.example.test/api/v1/collections"); err != nil {
+		t.Fatal(err)
+	}
+
+	if proxied == nil {
+		t.Fatal("request did not go through the proxy")
+	}
+	if proxied.URL.String() != "http://console.example.test/api/v1/collections" {
+		t.Errorf("proxy got %q", proxied.URL)
+	}
+	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte("proxy-user:proxy-pass"))
+	if got := proxied.Header.Get("Proxy-Authorization"); got != auth {
+		t.Errorf("got Proxy-Authorization %q, expected %q", got, auth)
+	}
+	if got := proxied.Header.Get("X-Waf-Token"); got != "abc" {
+		t.Errorf("got X-Waf-Token %q, expected abc", got)
+	}
+}
+
+func TestTransportNoProxy(t *testing.T) {
+	proxyFunc, err := transportConfig{
+		proxyUrl: "http://proxy.example.test:3128",
+		noProxy:  "internal.example.test,10.0.0.0/8",
+	}.proxyFunc()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]bool{
+		"https://console.example.test":          true,
+		"https://console.internal.example.test": false,
+		"https://10.1.2.3:8083":                 false,
+	}
+	for target, useProxy := range cases {
+		u, _ := url.Parse(target)
+		proxy, err := proxyFunc(&http.Request{URL: u})
+		if err != nil {
+			t.Fatalf("%s: %s", target, err)
+		}
+		if (proxy != nil) != useProxy {
+			t.Errorf("%s: got proxy %v, expected proxy: %t", target, proxy, useProxy)
+		}
+	}
+
+	if _, err := (transportConfig{proxyUrl: "::not a url"}).proxyFunc(); err == nil {
+		t.Errorf("expected an error for an invalid proxy_url")
+	}
+}
+
+func TestValidateHeaders(t *testing.T) {
+	if _, es := validateHeaders(map[string]interface{}{"X-Waf-Token": "abc"}, "custom_headers"); len(es) != 0 {
+		t.Errorf("unexpected errors: %v", es)
+	}
+	if _, es := validateHeaders(map[string]interface{}{"Bad Header": "abc", "X-Ok": "a\nb"}, "custom_headers"); len(es) != 2 {
+		t.Errorf("got errors %v, expected 2", es)
+	}
+}
+
+func TestTransportHeadersOverTls(t *testing.T) {
+	var got string
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("X-Waf-Token")
+	}))
+	defer srv.Close()
+
+	tc := transportConfig{caCertPem: certPem(srv), headers: map[string]string{"X-Waf-Token": "abc"}}
+	if err := transportGet(t, tc, srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if got != "abc" {
+		t.Errorf("got X-Waf-Token %q, expected abc", got)
+	}
+}